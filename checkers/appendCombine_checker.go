@@ -19,6 +19,9 @@ func init() {
 xs = append(xs, 1)
 xs = append(xs, 2)`
 	info.After = `xs = append(xs, 1, 2)`
+	info.Note = `Also detects if-else statements that append a single element
+to the same slice in both branches; the element can be selected
+conditionally and appended once.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		return astwalk.WalkerForStmtList(&appendCombineChecker{ctx: ctx})
@@ -46,6 +49,9 @@ func (c *appendCombineChecker) VisitStmtList(list []ast.Stmt) {
 	}
 
 	for _, stmt := range list {
+		if stmt, ok := stmt.(*ast.IfStmt); ok {
+			c.checkIfBranches(stmt)
+		}
 		call := c.matchAppend(stmt, slice)
 		if call == nil {
 			flush()
@@ -97,6 +103,27 @@ func (c *appendCombineChecker) matchAppend(stmt ast.Stmt, slice ast.Expr) *ast.C
 	return nil
 }
 
+// checkIfBranches finds `if cond { xs = append(xs, a) } else { xs = append(xs, b) }`.
+func (c *appendCombineChecker) checkIfBranches(stmt *ast.IfStmt) {
+	els, ok := stmt.Else.(*ast.BlockStmt)
+	if !ok || len(stmt.Body.List) != 1 || len(els.List) != 1 {
+		return
+	}
+	call1 := c.matchAppend(stmt.Body.List[0], nil)
+	if call1 == nil || len(call1.Args) != 2 {
+		return
+	}
+	call2 := c.matchAppend(els.List[0], call1.Args[0])
+	if call2 == nil || len(call2.Args) != 2 {
+		return
+	}
+	c.warnBranches(stmt, call1.Args[0])
+}
+
 func (c *appendCombineChecker) warn(cause ast.Node, chain int) {
 	c.ctx.Warn(cause, "can combine chain of %d appends into one", chain)
 }
+
+func (c *appendCombineChecker) warnBranches(cause ast.Node, slice ast.Expr) {
+	c.ctx.Warn(cause, "both if branches append to %s; can append a conditionally selected element once", slice)
+}
@@ -0,0 +1,133 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "atomicAlignment"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects 64-bit atomic operations on fields that are misaligned on 32-bit platforms"
+	info.Details = `On 386 and 32-bit arm, 64-bit atomic operations panic unless the address
+is 8-byte aligned. Only the first word of an allocated struct is guaranteed to be aligned,
+so field offsets are computed with the 32-bit platform sizes.
+atomic.Int64 that is always aligned is only suggested for Go 1.19 and later.`
+	info.Before = `
+type stats struct {
+	enabled bool
+	hits    int64
+}
+atomic.AddInt64(&s.hits, 1)`
+	info.After = `
+type stats struct {
+	hits    atomic.Int64
+	enabled bool
+}
+s.hits.Add(1)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&atomicAlignmentChecker{
+			ctx:   ctx,
+			sizes: types.SizesFor("gc", "386"),
+		})
+	})
+}
+
+type atomicAlignmentChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// sizes describe a 32-bit platform, where 64-bit words
+	// are only 4-byte aligned.
+	sizes types.Sizes
+}
+
+func (c *atomicAlignmentChecker) VisitExpr(x ast.Expr) {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "sync/atomic" {
+		return
+	}
+	if !strings.HasSuffix(fn.Name(), "Int64") && !strings.HasSuffix(fn.Name(), "Uint64") {
+		return
+	}
+	addr := astcast.ToUnaryExpr(call.Args[0])
+	if addr.Op != token.AND {
+		return
+	}
+	field, ok := addr.X.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	if offset, ok := c.offsetOf(field); ok && offset%8 != 0 {
+		c.warn(field, offset)
+	}
+}
+
+// offsetOf returns x offset from the beginning of the allocated
+// object it belongs to. Returns false if it can't be computed.
+func (c *atomicAlignmentChecker) offsetOf(x ast.Expr) (int64, bool) {
+	sel, ok := x.(*ast.SelectorExpr)
+	if !ok {
+		// Variables are allocated separately, so they're aligned.
+		_, ok := x.(*ast.Ident)
+		return 0, ok
+	}
+	selection, ok := c.ctx.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return 0, false
+	}
+
+	var offset int64
+	typ := selection.Recv()
+	if _, isPtr := typ.Underlying().(*types.Pointer); !isPtr {
+		base, ok := c.offsetOf(sel.X)
+		if !ok {
+			return 0, false
+		}
+		offset = base
+	}
+	for _, index := range selection.Index() {
+		if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+			// Pointed object is a new allocation.
+			typ = ptr.Elem()
+			offset = 0
+		}
+		strct, ok := typ.Underlying().(*types.Struct)
+		if !ok {
+			return 0, false
+		}
+		fields := make([]*types.Var, strct.NumFields())
+		for i := range fields {
+			fields[i] = strct.Field(i)
+		}
+		offset += c.sizes.Offsetsof(fields)[index]
+		typ = fields[index].Type()
+	}
+	return offset, true
+}
+
+func (c *atomicAlignmentChecker) warn(field *ast.SelectorExpr, offset int64) {
+	if !c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 19}) {
+		c.ctx.Warn(field, "%s is not 64-bit aligned on 32-bit platforms (offset %d); move it to the struct beginning",
+			field, offset)
+		return
+	}
+	c.ctx.Warn(field, "%s is not 64-bit aligned on 32-bit platforms (offset %d); move it to the struct beginning or use atomic.Int64",
+		field, offset)
+}
@@ -0,0 +1,226 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "blockingSendNoReceiver"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects goroutines that leak on unbuffered channel send when the receiver gives up"
+	info.Details = `If the only receive happens inside a select with a timeout or default case,
+the goroutine blocks on send forever after the other case is chosen.`
+	info.Before = `
+ch := make(chan result)
+go func() { ch <- compute() }()
+select {
+case r := <-ch:
+	return r
+case <-time.After(timeout):
+	return nil
+}`
+	info.After = `
+ch := make(chan result, 1)
+go func() { ch <- compute() }()
+select {
+case r := <-ch:
+	return r
+case <-time.After(timeout):
+	return nil
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&blockingSendNoReceiverChecker{ctx: ctx})
+	})
+}
+
+type blockingSendNoReceiverChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *blockingSendNoReceiverChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		id, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok || !c.isUnbufferedMake(assign.Rhs[0]) {
+			return true
+		}
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			c.checkChan(decl.Body, obj)
+		}
+		return true
+	})
+}
+
+// checkChan reports goroutine sends on ch if every receive from ch
+// outside of the goroutines is a select case that may be skipped
+// in favor of a default, timeout or context cancellation case.
+func (c *blockingSendNoReceiverChecker) checkChan(body *ast.BlockStmt, ch types.Object) {
+	var goroutines []*ast.FuncLit
+	var sends []*ast.SendStmt
+	selectRecvs := make(map[*ast.Ident]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			lit, ok := n.Call.Fun.(*ast.FuncLit)
+			if !ok {
+				return true
+			}
+			numSends := len(sends)
+			ast.Inspect(lit.Body, func(n ast.Node) bool {
+				if send, ok := n.(*ast.SendStmt); ok && c.isObj(send.Chan, ch) {
+					sends = append(sends, send)
+				}
+				return true
+			})
+			if len(sends) != numSends {
+				goroutines = append(goroutines, lit)
+			}
+		case *ast.SelectStmt:
+			if !c.canGiveUp(n) {
+				return true
+			}
+			for _, stmt := range n.Body.List {
+				if id := c.recvChan(stmt.(*ast.CommClause).Comm); id != nil {
+					selectRecvs[id] = true
+				}
+			}
+		}
+		return true
+	})
+	if len(sends) == 0 || len(selectRecvs) == 0 {
+		return
+	}
+
+	// Any other usage of the channel outside of the sending goroutines
+	// may be a receive that drains it.
+	escapes := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			for _, lit := range goroutines {
+				if lit == n {
+					return false
+				}
+			}
+		case *ast.Ident:
+			if c.ctx.TypesInfo.Uses[n] == ch && !selectRecvs[n] {
+				escapes = true
+			}
+		}
+		return !escapes
+	})
+	if escapes {
+		return
+	}
+
+	for _, send := range sends {
+		c.warn(send)
+	}
+}
+
+// canGiveUp reports whether sel has a default, timer or ctx.Done() case,
+// so it may stop waiting for the other cases.
+func (c *blockingSendNoReceiverChecker) canGiveUp(sel *ast.SelectStmt) bool {
+	for _, stmt := range sel.Body.List {
+		comm := stmt.(*ast.CommClause).Comm
+		if comm == nil {
+			return true // default case
+		}
+		switch x := astutil.Unparen(c.recvOperand(comm)).(type) {
+		case *ast.CallExpr:
+			// time.After(d), time.Tick(d) or ctx.Done().
+			fn, ok := c.ctx.TypesInfo.ObjectOf(identOf(x.Fun)).(*types.Func)
+			if !ok {
+				continue
+			}
+			switch fn.FullName() {
+			case "time.After", "time.Tick", "(context.Context).Done":
+				return true
+			}
+		case *ast.SelectorExpr:
+			// timer.C or ticker.C.
+			if x.Sel.Name != "C" {
+				continue
+			}
+			switch c.ctx.TypeOf(x.X).String() {
+			case "*time.Timer", "*time.Ticker":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// recvChan returns a channel identifier from `case <-ch` or `case x := <-ch`.
+func (c *blockingSendNoReceiverChecker) recvChan(comm ast.Stmt) *ast.Ident {
+	id, _ := c.recvOperand(comm).(*ast.Ident)
+	return id
+}
+
+// recvOperand returns a ch expression from `case <-ch` or `case x := <-ch`.
+func (c *blockingSendNoReceiverChecker) recvOperand(comm ast.Stmt) ast.Expr {
+	var x ast.Expr
+	switch comm := comm.(type) {
+	case *ast.ExprStmt:
+		x = comm.X
+	case *ast.AssignStmt:
+		if len(comm.Rhs) != 1 {
+			return nil
+		}
+		x = comm.Rhs[0]
+	default:
+		return nil
+	}
+	recv := astcast.ToUnaryExpr(x)
+	if recv.Op != token.ARROW {
+		return nil
+	}
+	return recv.X
+}
+
+func (c *blockingSendNoReceiverChecker) isUnbufferedMake(x ast.Expr) bool {
+	call := astcast.ToCallExpr(x)
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "make" {
+		return false
+	}
+	if _, ok := c.ctx.TypesInfo.ObjectOf(fn).(*types.Builtin); !ok {
+		return false
+	}
+	if _, ok := c.ctx.TypeOf(call).Underlying().(*types.Chan); !ok {
+		return false
+	}
+	switch len(call.Args) {
+	case 1:
+		return true
+	case 2:
+		return astcast.ToBasicLit(call.Args[1]).Value == "0"
+	default:
+		return false
+	}
+}
+
+func (c *blockingSendNoReceiverChecker) isObj(x ast.Expr, obj types.Object) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.Uses[id] == obj
+}
+
+func (c *blockingSendNoReceiverChecker) warn(cause *ast.SendStmt) {
+	c.ctx.Warn(cause, "goroutine blocks forever on %s send if select takes another case; make the channel buffered with size 1", cause.Chan)
+}
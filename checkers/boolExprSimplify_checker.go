@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
 	"strconv"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
@@ -12,6 +13,7 @@ import (
 	"github.com/go-toolsmith/astcast"
 	"github.com/go-toolsmith/astcopy"
 	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
 	"github.com/go-toolsmith/astp"
 	"github.com/go-toolsmith/typep"
 	"golang.org/x/tools/go/ast/astutil"
@@ -22,12 +24,16 @@ func init() {
 	info.Name = "boolExprSimplify"
 	info.Tags = []string{"style", "experimental"}
 	info.Summary = "Detects bool expressions that can be simplified"
+	info.Details = `Also applies De Morgan's laws when all negations can be folded,
+removes comparisons with bool constants and folds && and || with constant operands.`
 	info.Before = `
 a := !(elapsed >= expectElapsedMin)
-b := !(x) == !(y)`
+b := !(x) == !(y)
+c := !(n > 0 && ok == true)`
 	info.After = `
 a := elapsed < expectElapsedMin
-b := (x) == (y)`
+b := (x) == (y)
+c := n <= 0 || !ok`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		return astwalk.WalkerForExpr(&boolExprSimplifyChecker{ctx: ctx})
@@ -38,6 +44,10 @@ type boolExprSimplifyChecker struct {
 	astwalk.WalkHandler
 	ctx       *linter.CheckerContext
 	hasFloats bool
+
+	// shadowedBools is set when true or false identifiers
+	// don't refer to the predeclared constants, so they can't be folded.
+	shadowedBools bool
 }
 
 func (c *boolExprSimplifyChecker) VisitExpr(x ast.Expr) {
@@ -50,6 +60,10 @@ func (c *boolExprSimplifyChecker) VisitExpr(x ast.Expr) {
 	if typ := c.ctx.TypeOf(x); typ == nil || !typep.HasBoolKind(typ.Underlying()) {
 		return
 	}
+	// Constant expressions are usually written that way on purpose.
+	if c.ctx.TypesInfo.Types[x].Value != nil {
+		return
+	}
 
 	// We'll loose all types info after a copy,
 	// this is why we record valuable info before doing it.
@@ -60,6 +74,13 @@ func (c *boolExprSimplifyChecker) VisitExpr(x ast.Expr) {
 		}
 		return false
 	})
+	c.shadowedBools = lintutil.ContainsNode(x, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok || (id.Name != "true" && id.Name != "false") {
+			return false
+		}
+		return c.ctx.TypesInfo.ObjectOf(id) != types.Universe.Lookup(id.Name)
+	})
 
 	y := c.simplifyBool(astcopy.Expr(x))
 	if !astequal.Expr(x, y) {
@@ -72,6 +93,9 @@ func (c *boolExprSimplifyChecker) simplifyBool(x ast.Expr) ast.Expr {
 		return c.doubleNegation(cur) ||
 			c.negatedEquals(cur) ||
 			c.invertComparison(cur) ||
+			c.deMorgan(cur) ||
+			c.compareWithConst(cur) ||
+			c.foldConstOperand(cur) ||
 			c.combineChecks(cur) ||
 			c.removeIncDec(cur) ||
 			c.foldRanges(cur) ||
@@ -137,6 +161,139 @@ func (c *boolExprSimplifyChecker) invertComparison(cur *astutil.Cursor) bool {
 	return true
 }
 
+// deMorgan rewrites `!(x && y)` to `!x || !y` and `!(x || y)` to `!x && !y`
+// when both operand negations can be simplified.
+func (c *boolExprSimplifyChecker) deMorgan(cur *astutil.Cursor) bool {
+	neg := astcast.ToUnaryExpr(cur.Node())
+	e := astcast.ToBinaryExpr(astutil.Unparen(neg.X))
+	if neg.Op != token.NOT || (e.Op != token.LAND && e.Op != token.LOR) {
+		return false
+	}
+	if !c.isSafe(e.X) || !c.isSafe(e.Y) {
+		return false
+	}
+	x, ok := c.simpleNegation(e.X)
+	if !ok {
+		return false
+	}
+	y, ok := c.simpleNegation(e.Y)
+	if !ok {
+		return false
+	}
+	op := token.LAND
+	if e.Op == token.LAND {
+		op = token.LOR
+	}
+	cur.Replace(&ast.BinaryExpr{X: x, Op: op, Y: y})
+	return true
+}
+
+// simpleNegation returns negated x if it doesn't require the `!` operator.
+func (c *boolExprSimplifyChecker) simpleNegation(x ast.Expr) (ast.Expr, bool) {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.UnaryExpr:
+		if x.Op == token.NOT {
+			return x.X, true
+		}
+	case *ast.Ident:
+		if c.shadowedBools {
+			break
+		}
+		switch x.Name {
+		case "true":
+			return &ast.Ident{Name: "false"}, true
+		case "false":
+			return &ast.Ident{Name: "true"}, true
+		}
+	case *ast.BinaryExpr:
+		ops := map[token.Token]token.Token{
+			token.EQL: token.NEQ,
+			token.NEQ: token.EQL,
+		}
+		if !c.hasFloats { // See #673
+			ops[token.LSS] = token.GEQ
+			ops[token.GTR] = token.LEQ
+			ops[token.LEQ] = token.GTR
+			ops[token.GEQ] = token.LSS
+		}
+		if op, ok := ops[x.Op]; ok {
+			return &ast.BinaryExpr{X: x.X, Op: op, Y: x.Y}, true
+		}
+	}
+	return nil, false
+}
+
+// compareWithConst rewrites `x == true` and `x != false` to `x`,
+// `x == false` and `x != true` to `!x`.
+func (c *boolExprSimplifyChecker) compareWithConst(cur *astutil.Cursor) bool {
+	e, ok := cur.Node().(*ast.BinaryExpr)
+	if !ok || (e.Op != token.EQL && e.Op != token.NEQ) {
+		return false
+	}
+	x, val := e.X, c.boolConst(e.Y)
+	if val == "" {
+		x, val = e.Y, c.boolConst(e.X)
+	}
+	if val == "" {
+		return false
+	}
+	if (e.Op == token.EQL) == (val == "true") {
+		cur.Replace(x)
+		return true
+	}
+	if neg, ok := c.simpleNegation(x); ok {
+		cur.Replace(neg)
+		return true
+	}
+	if astp.IsBinaryExpr(x) {
+		x = &ast.ParenExpr{X: x}
+	}
+	cur.Replace(&ast.UnaryExpr{Op: token.NOT, X: x})
+	return true
+}
+
+// foldConstOperand rewrites `x && true` and `x || false` to `x`,
+// `x && false` to `false` and `x || true` to `true`.
+func (c *boolExprSimplifyChecker) foldConstOperand(cur *astutil.Cursor) bool {
+	e, ok := cur.Node().(*ast.BinaryExpr)
+	if !ok || (e.Op != token.LAND && e.Op != token.LOR) {
+		return false
+	}
+	// Value of the constant operand that doesn't affect the result.
+	neutral := "true"
+	if e.Op == token.LOR {
+		neutral = "false"
+	}
+	switch lhs, rhs := c.boolConst(e.X), c.boolConst(e.Y); {
+	case lhs == neutral:
+		cur.Replace(e.Y)
+	case rhs == neutral:
+		cur.Replace(e.X)
+	case lhs != "":
+		// The rhs is never evaluated.
+		cur.Replace(e.X)
+	case rhs != "" && c.isSafe(e.X):
+		cur.Replace(e.Y)
+	default:
+		return false
+	}
+	return true
+}
+
+// boolConst returns "true" or "false" if x is a bool constant.
+func (c *boolExprSimplifyChecker) boolConst(x ast.Expr) string {
+	if c.shadowedBools {
+		return ""
+	}
+	id := astcast.ToIdent(astutil.Unparen(x))
+	switch id.Name {
+	case "true", "false":
+		return id.Name
+	default:
+		return ""
+	}
+}
+
 func (c *boolExprSimplifyChecker) isSafe(x ast.Expr) bool {
 	return typep.SideEffectFree(c.ctx.TypesInfo, x)
 }
@@ -342,5 +499,12 @@ func (c *boolExprSimplifyChecker) int64val(x ast.Expr) (int64, bool) {
 
 func (c *boolExprSimplifyChecker) warn(cause, suggestion ast.Expr) {
 	c.SkipChilds = true
-	c.ctx.Warn(cause, "can simplify `%s` to `%s`", cause, suggestion)
+	// The cause is the outermost bool expression, so the suggestion
+	// can't have lower precedence than the expression it's used in.
+	fix := linter.QuickFix{
+		From:        cause.Pos(),
+		To:          cause.End(),
+		Replacement: []byte(astfmt.Sprint(suggestion)),
+	}
+	c.ctx.WarnFixable(cause, fix, "can simplify `%s` to `%s`", cause, suggestion)
 }
@@ -0,0 +1,67 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "boolParamClutter"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"minBoolParams": {
+			Value: 2,
+			Usage: "min number of plain bool params that triggers the warning",
+		},
+	}
+	info.Summary = "Detects functions with several plain bool parameters"
+	info.Details = "Call sites like f(true, false, true) are hard to read; an options struct or defined flag types make them self-documenting."
+	info.Before = `func render(w io.Writer, pretty, escape bool)`
+	info.After = `
+type renderOptions struct {
+	Pretty bool
+	Escape bool
+}
+func render(w io.Writer, opts renderOptions)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&boolParamClutterChecker{
+			ctx:           ctx,
+			minBoolParams: info.Params.Int("minBoolParams"),
+		})
+	})
+}
+
+type boolParamClutterChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	minBoolParams int
+}
+
+func (c *boolParamClutterChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	n := 0
+	for _, field := range decl.Type.Params.List {
+		// Defined boolean types (like `type verbose bool`) are fine.
+		if c.ctx.TypeOf(field.Type) != types.Typ[types.Bool] {
+			continue
+		}
+		if len(field.Names) == 0 {
+			n++
+		} else {
+			n += len(field.Names)
+		}
+	}
+	if n >= c.minBoolParams {
+		c.warn(decl, n)
+	}
+}
+
+func (c *boolParamClutterChecker) warn(decl *ast.FuncDecl, n int) {
+	c.ctx.Warn(decl.Name, "%s has %d bool params; consider an options struct or defined flag types",
+		decl.Name, n)
+}
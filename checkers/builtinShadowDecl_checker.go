@@ -53,7 +53,7 @@ func (c *builtinShadowDeclChecker) visitGenDecl(decl *ast.GenDecl) {
 }
 
 func (c *builtinShadowDeclChecker) checkName(name *ast.Ident) {
-	if isBuiltin(name.Name) {
+	if isBuiltinForVersion(name.Name, c.ctx.GoVersion) {
 		c.warn(name)
 	}
 }
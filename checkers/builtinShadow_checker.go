@@ -2,6 +2,8 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
@@ -12,6 +14,8 @@ func init() {
 	info.Name = "builtinShadow"
 	info.Tags = []string{"style", "opinionated"}
 	info.Summary = "Detects when predeclared identifiers are shadowed in assignments"
+	info.Details = `The warning is more specific if the shadowed builtin
+is used later in the same function, since the code becomes confusing to read.`
 	info.Before = `len := 10`
 	info.After = `length := 10`
 
@@ -23,14 +27,53 @@ func init() {
 type builtinShadowChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
+
+	fn *ast.FuncDecl
+
+	// builtinUses maps predeclared identifiers that are used
+	// inside fn to their last usage position. Collected lazily.
+	builtinUses map[string]token.Pos
+}
+
+func (c *builtinShadowChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	c.fn = fn
+	c.builtinUses = nil
+	return fn.Body != nil
 }
 
 func (c *builtinShadowChecker) VisitLocalDef(name astwalk.Name, _ ast.Expr) {
-	if isBuiltin(name.ID.Name) {
-		c.warn(name.ID)
+	if !isBuiltinForVersion(name.ID.Name, c.ctx.GoVersion) {
+		return
+	}
+	if c.builtinUses == nil {
+		c.builtinUses = c.collectBuiltinUses()
+	}
+	if c.builtinUses[name.ID.Name] > name.ID.Pos() {
+		c.warnUsed(name.ID)
+		return
 	}
+	c.warn(name.ID)
+}
+
+func (c *builtinShadowChecker) collectBuiltinUses() map[string]token.Pos {
+	uses := make(map[string]token.Pos)
+	ast.Inspect(c.fn.Body, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok || !goBuiltins[id.Name] {
+			return true
+		}
+		if obj := c.ctx.TypesInfo.Uses[id]; obj != nil && obj.Parent() == types.Universe {
+			uses[id.Name] = id.Pos()
+		}
+		return true
+	})
+	return uses
 }
 
 func (c *builtinShadowChecker) warn(ident *ast.Ident) {
 	c.ctx.Warn(ident, "shadowing of predeclared identifier: %s", ident)
 }
+
+func (c *builtinShadowChecker) warnUsed(ident *ast.Ident) {
+	c.ctx.Warn(ident, "shadowing of predeclared identifier: %s, which is used as a builtin later in this function", ident)
+}
@@ -0,0 +1,83 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "busySelectLoop"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects infinite loops over select with a non-blocking default case"
+	info.Details = "Such loops spin a CPU core while waiting for channel events."
+	info.Before = `
+for {
+	select {
+	case msg := <-ch:
+		handle(msg)
+	default:
+	}
+}`
+	info.After = `
+for {
+	select {
+	case msg := <-ch:
+		handle(msg)
+	}
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForStmt(&busySelectLoopChecker{ctx: ctx})
+	})
+}
+
+type busySelectLoopChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *busySelectLoopChecker) VisitStmt(stmt ast.Stmt) {
+	loop, ok := stmt.(*ast.ForStmt)
+	if !ok || loop.Cond != nil || len(loop.Body.List) != 1 {
+		return
+	}
+	sel, ok := loop.Body.List[0].(*ast.SelectStmt)
+	if !ok {
+		return
+	}
+	for _, stmt := range sel.Body.List {
+		clause := stmt.(*ast.CommClause)
+		if clause.Comm == nil && !c.doesWork(clause.Body) {
+			c.warn(clause)
+		}
+	}
+}
+
+// doesWork reports whether any of the statements may block,
+// do some work or leave the loop.
+func (c *busySelectLoopChecker) doesWork(list []ast.Stmt) bool {
+	found := false
+	for _, stmt := range list {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CallExpr, *ast.SendStmt, *ast.ReturnStmt, *ast.GoStmt:
+				found = true
+			case *ast.UnaryExpr:
+				found = found || n.Op == token.ARROW
+			case *ast.BranchStmt:
+				// Unlabeled break only leaves the select statement.
+				found = found || n.Tok == token.GOTO || n.Label != nil
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+func (c *busySelectLoopChecker) warn(cause *ast.CommClause) {
+	c.ctx.Warn(cause, "default case makes the loop spin the CPU; remove it or add a wait mechanism")
+}
@@ -0,0 +1,119 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "bytesCompareSimplify"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects bytes.Compare and strings.Compare results compared with 0"
+	info.Before = `
+bytes.Compare(a, b) == 0
+strings.Compare(s1, s2) < 0`
+	info.After = `
+bytes.Equal(a, b)
+s1 < s2`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&bytesCompareSimplifyChecker{ctx: ctx})
+	})
+}
+
+type bytesCompareSimplifyChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *bytesCompareSimplifyChecker) VisitExpr(x ast.Expr) {
+	expr, ok := x.(*ast.BinaryExpr)
+	if !ok {
+		return
+	}
+	op := expr.Op
+	call := astcast.ToCallExpr(expr.X)
+	zero := expr.Y
+	if !c.isZero(zero) {
+		// Handle the `0 < strings.Compare(x, y)` form.
+		call = astcast.ToCallExpr(expr.Y)
+		zero = expr.X
+		op = c.mirrorOp(op)
+	}
+	if !c.isZero(zero) || len(call.Args) != 2 {
+		return
+	}
+
+	var suggestion ast.Expr
+	switch c.funcName(call) {
+	case "bytes.Compare":
+		equal := &ast.CallExpr{
+			Fun:  &ast.SelectorExpr{X: call.Fun.(*ast.SelectorExpr).X, Sel: ast.NewIdent("Equal")},
+			Args: call.Args,
+		}
+		switch op {
+		case token.EQL:
+			suggestion = equal
+		case token.NEQ:
+			suggestion = &ast.UnaryExpr{Op: token.NOT, X: equal}
+		}
+	case "strings.Compare":
+		switch op {
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			suggestion = &ast.BinaryExpr{X: call.Args[0], Op: op, Y: call.Args[1]}
+		}
+	}
+	if suggestion != nil {
+		c.warn(expr, suggestion)
+	}
+}
+
+func (c *bytesCompareSimplifyChecker) funcName(call *ast.CallExpr) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return ""
+	}
+	return fn.FullName()
+}
+
+func (c *bytesCompareSimplifyChecker) isZero(x ast.Expr) bool {
+	value, ok := x.(*ast.BasicLit)
+	return ok && value.Value == "0"
+}
+
+// mirrorOp returns an operator for the swapped operands.
+func (c *bytesCompareSimplifyChecker) mirrorOp(op token.Token) token.Token {
+	switch op {
+	case token.LSS:
+		return token.GTR
+	case token.LEQ:
+		return token.GEQ
+	case token.GTR:
+		return token.LSS
+	case token.GEQ:
+		return token.LEQ
+	default:
+		return op
+	}
+}
+
+func (c *bytesCompareSimplifyChecker) warn(cause *ast.BinaryExpr, suggestion ast.Expr) {
+	s := astfmt.Sprint(suggestion)
+	fix := linter.QuickFix{
+		From:        cause.Pos(),
+		To:          cause.End(),
+		Replacement: []byte(s),
+	}
+	c.ctx.WarnFixable(cause, fix, "%s can be simplified to %s", cause, s)
+}
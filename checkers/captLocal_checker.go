@@ -2,6 +2,7 @@ package checkers
 
 import (
 	"go/ast"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
@@ -14,7 +15,11 @@ func init() {
 	info.Params = linter.CheckerParams{
 		"paramsOnly": {
 			Value: true,
-			Usage: "whether to restrict checker to params only",
+			Usage: "whether to restrict checker to params only; set to false to check local variables and constants too",
+		},
+		"allowNames": {
+			Value: "",
+			Usage: "comma-separated list of capitalized names that are allowed, like ID or DB",
 		},
 	}
 	info.Summary = "Detects capitalized names for local variables"
@@ -24,6 +29,12 @@ func init() {
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		c := &captLocalChecker{ctx: ctx}
 		c.paramsOnly = info.Params.Bool("paramsOnly")
+		c.allowNames = make(map[string]bool)
+		for _, name := range strings.Split(info.Params.String("allowNames"), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				c.allowNames[name] = true
+			}
+		}
 		return astwalk.WalkerForLocalDef(c, ctx.TypesInfo)
 	})
 }
@@ -33,13 +44,14 @@ type captLocalChecker struct {
 	ctx *linter.CheckerContext
 
 	paramsOnly bool
+	allowNames map[string]bool
 }
 
 func (c *captLocalChecker) VisitLocalDef(def astwalk.Name, _ ast.Expr) {
 	if c.paramsOnly && def.Kind != astwalk.NameParam {
 		return
 	}
-	if ast.IsExported(def.ID.Name) {
+	if ast.IsExported(def.ID.Name) && !c.allowNames[def.ID.Name] {
 		c.warn(def.ID)
 	}
 }
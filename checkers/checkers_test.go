@@ -8,8 +8,16 @@ import (
 )
 
 func TestCheckers(t *testing.T) {
+	const testdata = "github.com/go-critic/go-critic/checkers/testdata"
 	allParams := map[string]map[string]interface{}{
-		"captLocal": {"paramsOnly": false},
+		"captLocal":           {"paramsOnly": false, "allowNames": "ID, DB"},
+		"cognitiveComplexity": {"maxComplexity": 5},
+		"contextInStruct":     {"allowTypes": "requestCarrier, otherCarrier"},
+		"exitAfterDefer":      {"exitFuncs": testdata + "/exitAfterDefer.fatalf, (*" + testdata + "/exitAfterDefer.logger).Fatal"},
+		"jsonTagNaming":       {"checkYaml": true},
+		"longParameterList":   {"exportedOnly": true},
+		"panicInLibrary":      {"skipFuncPrefixes": "Must, Assert"},
+		"sloppyLen":           {"checkConsistency": true},
 	}
 
 	for _, info := range linter.GetCheckersInfo() {
@@ -22,6 +30,13 @@ func TestCheckers(t *testing.T) {
 		}
 	}
 
+	linttest.GoVersions = map[string]linter.GoVersion{
+		"errorWrapVerb":  {Major: 1, Minor: 19},
+		"loopVarCapture": {Major: 1, Minor: 21},
+		"tickerLeak":     {Major: 1, Minor: 22},
+		"unlambda":       {Major: 1, Minor: 20},
+	}
+
 	linttest.TestCheckers(t)
 }
 
@@ -140,3 +155,22 @@ func TestStableList(t *testing.T) {
 		}
 	}
 }
+
+func TestJSONTagNamingInvalidNaming(t *testing.T) {
+	var info *linter.CheckerInfo
+	for _, x := range linter.GetCheckersInfo() {
+		if x.Name == "jsonTagNaming" {
+			info = x
+		}
+	}
+	param := info.Params["naming"]
+	defer func(v interface{}) { param.Value = v }(param.Value)
+	param.Value = "kebab"
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected a panic for the invalid naming param")
+		}
+	}()
+	linter.NewChecker(linter.NewContext(nil, nil), info)
+}
@@ -0,0 +1,172 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "cognitiveComplexity"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"maxComplexity": {
+			Value: 15,
+			Usage: "max cognitive complexity of a function that doesn't trigger a warning",
+		},
+	}
+	info.Summary = "Detects functions with too high cognitive complexity"
+	info.Details = `Cognitive complexity measures how hard the function control flow is to read.
+Each if, else, switch, select, loop, labeled jump, recursive call and a sequence
+of like logical operators adds 1; nested control flow statements
+add their nesting level on top of that.`
+	info.Before = `
+func f(xs []int) {
+	for _, x := range xs {      // +1
+		if x > 0 {              // +2 (nesting = 1)
+			if x%2 == 0 {       // +3 (nesting = 2)
+				...
+			}
+		}
+	}
+}`
+	info.After = `
+func f(xs []int) {
+	for _, x := range xs { // +1
+		if isPositiveEven(x) { // +2 (nesting = 1)
+			...
+		}
+	}
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&cognitiveComplexityChecker{
+			ctx:           ctx,
+			maxComplexity: info.Params.Int("maxComplexity"),
+		})
+	})
+}
+
+type cognitiveComplexityChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	maxComplexity int
+
+	fn         *types.Func
+	complexity int
+}
+
+func (c *cognitiveComplexityChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	c.fn, _ = c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	c.complexity = 0
+	c.walk(decl.Body, 0)
+	if c.complexity > c.maxComplexity {
+		c.warn(decl)
+	}
+}
+
+// walk computes the complexity of n with a given nesting level.
+func (c *cognitiveComplexityChecker) walk(n ast.Node, nesting int) {
+	switch n := n.(type) {
+	case *ast.IfStmt:
+		c.complexity += 1 + nesting
+		c.walkIf(n, nesting)
+		return
+	case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+		c.complexity += 1 + nesting
+		c.walkChildren(n, nesting, c.bodyOf(n))
+		return
+	case *ast.FuncLit:
+		c.walk(n.Body, nesting+1)
+		return
+	case *ast.BranchStmt:
+		if n.Tok == token.GOTO || n.Label != nil {
+			c.complexity++
+		}
+	case *ast.BinaryExpr:
+		if n.Op == token.LAND || n.Op == token.LOR {
+			c.complexity++
+			c.walkLogical(n.X, n.Op, nesting)
+			c.walkLogical(n.Y, n.Op, nesting)
+			return
+		}
+	case *ast.CallExpr:
+		if id, ok := n.Fun.(*ast.Ident); ok && c.fn != nil && c.ctx.TypesInfo.ObjectOf(id) == c.fn {
+			c.complexity++ // Recursion
+		}
+	}
+	c.walkChildren(n, nesting, nil)
+}
+
+// walkIf handles if statement and its else branches.
+// The if statement itself should be accounted by the caller.
+func (c *cognitiveComplexityChecker) walkIf(n *ast.IfStmt, nesting int) {
+	if n.Init != nil {
+		c.walk(n.Init, nesting)
+	}
+	c.walk(n.Cond, nesting)
+	c.walk(n.Body, nesting+1)
+	switch e := n.Else.(type) {
+	case *ast.IfStmt:
+		c.complexity++ // else if
+		c.walkIf(e, nesting)
+	case *ast.BlockStmt:
+		c.complexity++ // else
+		c.walk(e, nesting+1)
+	}
+}
+
+// walkLogical handles logical operands; a sequence of
+// the same operators is counted only once.
+func (c *cognitiveComplexityChecker) walkLogical(x ast.Expr, op token.Token, nesting int) {
+	if e, ok := x.(*ast.BinaryExpr); ok && e.Op == op {
+		c.walkLogical(e.X, op, nesting)
+		c.walkLogical(e.Y, op, nesting)
+		return
+	}
+	c.walk(x, nesting)
+}
+
+// walkChildren walks n children; body is walked with increased nesting.
+func (c *cognitiveComplexityChecker) walkChildren(n ast.Node, nesting int, body *ast.BlockStmt) {
+	ast.Inspect(n, func(x ast.Node) bool {
+		switch {
+		case x == n:
+			return true
+		case x == nil:
+			return false
+		case x == body:
+			c.walk(x, nesting+1)
+		default:
+			c.walk(x, nesting)
+		}
+		return false
+	})
+}
+
+func (c *cognitiveComplexityChecker) bodyOf(n ast.Node) *ast.BlockStmt {
+	switch n := n.(type) {
+	case *ast.ForStmt:
+		return n.Body
+	case *ast.RangeStmt:
+		return n.Body
+	case *ast.SwitchStmt:
+		return n.Body
+	case *ast.TypeSwitchStmt:
+		return n.Body
+	case *ast.SelectStmt:
+		return n.Body
+	default:
+		return nil
+	}
+}
+
+func (c *cognitiveComplexityChecker) warn(decl *ast.FuncDecl) {
+	c.ctx.Warn(decl.Name, "cognitive complexity of %s is %d, max is %d",
+		decl.Name, c.complexity, c.maxComplexity)
+}
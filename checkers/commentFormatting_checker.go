@@ -15,6 +15,12 @@ func init() {
 	var info linter.CheckerInfo
 	info.Name = "commentFormatting"
 	info.Tags = []string{"style"}
+	info.Params = linter.CheckerParams{
+		"directives": {
+			Value: "nolint,line,easyjson:,kubebuilder:",
+			Usage: "comma-separated list of tool directive prefixes that are permitted right after //",
+		},
+	}
 	info.Summary = "Detects comments with non-idiomatic formatting"
 	info.Before = `//This is a comment`
 	info.After = `// This is a comment`
@@ -29,10 +35,16 @@ func init() {
 		}
 		pat := "(?m)" + strings.Join(parts, "|")
 		pragmaRE := regexp.MustCompile(pat)
-		return astwalk.WalkerForComment(&commentFormattingChecker{
+		c := &commentFormattingChecker{
 			ctx:      ctx,
 			pragmaRE: pragmaRE,
-		})
+		}
+		for _, d := range strings.Split(info.Params.String("directives"), ",") {
+			if d = strings.TrimSpace(d); d != "" {
+				c.directives = append(c.directives, d)
+			}
+		}
+		return astwalk.WalkerForComment(c)
 	})
 }
 
@@ -40,7 +52,8 @@ type commentFormattingChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
-	pragmaRE *regexp.Regexp
+	pragmaRE   *regexp.Regexp
+	directives []string
 }
 
 func (c *commentFormattingChecker) VisitComment(cg *ast.CommentGroup) {
@@ -51,7 +64,7 @@ func (c *commentFormattingChecker) VisitComment(cg *ast.CommentGroup) {
 		if len(comment.Text) <= len("// ") {
 			continue
 		}
-		if c.pragmaRE.MatchString(comment.Text) {
+		if c.pragmaRE.MatchString(comment.Text) || c.isDirective(comment.Text[len("//"):]) {
 			continue
 		}
 
@@ -64,6 +77,27 @@ func (c *commentFormattingChecker) VisitComment(cg *ast.CommentGroup) {
 	}
 }
 
+// isDirective reports whether text starts with one of the directive prefixes.
+// Prefixes that end with a word rune must be followed by a non-word
+// rune, so "line" matches "line foo.go:10", but not "lines".
+func (c *commentFormattingChecker) isDirective(text string) bool {
+	for _, d := range c.directives {
+		if !strings.HasPrefix(text, d) {
+			continue
+		}
+		last, _ := utf8.DecodeLastRuneInString(d)
+		next, _ := utf8.DecodeRuneInString(text[len(d):])
+		if !c.isWordRune(last) || len(text) == len(d) || !c.isWordRune(next) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *commentFormattingChecker) isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
 func (c *commentFormattingChecker) specialChar(r rune) bool {
 	// Permitted list to avoid false-positives.
 	switch r {
@@ -0,0 +1,82 @@
+package checkers
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "contextInStruct"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowTypes": {
+			Value: "",
+			Usage: "comma-separated list of struct type names that may store a context",
+		},
+	}
+	info.Summary = "Detects struct fields of context.Context type"
+	info.Details = "Contexts should be passed explicitly as the first function parameter instead of being stored inside structs."
+	info.Before = `
+type worker struct {
+	ctx context.Context
+}
+func (w *worker) Do() error`
+	info.After = `
+type worker struct{}
+func (w *worker) Do(ctx context.Context) error`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &contextInStructChecker{
+			ctx:        ctx,
+			allowTypes: make(map[string]bool),
+		}
+		for _, name := range strings.Split(info.Params.String("allowTypes"), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				c.allowTypes[name] = true
+			}
+		}
+		return c
+	})
+}
+
+type contextInStructChecker struct {
+	ctx *linter.CheckerContext
+
+	allowTypes map[string]bool
+}
+
+func (c *contextInStructChecker) WalkFile(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if typ, ok := spec.Type.(*ast.StructType); ok && !c.allowTypes[spec.Name.Name] {
+			c.checkStruct(spec.Name, typ)
+		}
+		return true
+	})
+}
+
+func (c *contextInStructChecker) checkStruct(name *ast.Ident, typ *ast.StructType) {
+	for _, field := range typ.Fields.List {
+		if c.ctx.TypeOf(field.Type).String() != "context.Context" {
+			continue
+		}
+		if len(field.Names) == 0 {
+			c.warn(field, name, "Context")
+			continue
+		}
+		for _, id := range field.Names {
+			c.warn(id, name, id.Name)
+		}
+	}
+}
+
+func (c *contextInStructChecker) warn(cause ast.Node, typeName *ast.Ident, fieldName string) {
+	c.ctx.Warn(cause, "%s.%s stores a context.Context; pass it as the first function parameter instead",
+		typeName, fieldName)
+}
@@ -0,0 +1,49 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "contextKeyType"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects context.WithValue calls that use basic-type keys"
+	info.Details = "Keys of built-in types can collide with keys defined by other packages."
+	info.Before = `ctx = context.WithValue(ctx, "user", u)`
+	info.After = `
+type userKey struct{}
+ctx = context.WithValue(ctx, userKey{}, u)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&contextKeyTypeChecker{ctx: ctx})
+	})
+}
+
+type contextKeyTypeChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *contextKeyTypeChecker) VisitExpr(expr ast.Expr) {
+	call := astcast.ToCallExpr(expr)
+	if len(call.Args) != 3 || qualifiedName(call.Fun) != "context.WithValue" {
+		return
+	}
+	key := call.Args[1]
+	typ, ok := types.Default(c.ctx.TypeOf(key)).(*types.Basic)
+	if !ok || typ.Kind() == types.Invalid || typ.Kind() == types.UntypedNil {
+		return
+	}
+	c.warn(key, typ)
+}
+
+func (c *contextKeyTypeChecker) warn(key ast.Expr, typ types.Type) {
+	c.ctx.Warn(key, "%s key of built-in type %s may collide with other packages; define an unexported key type",
+		key, typ)
+}
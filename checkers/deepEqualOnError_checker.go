@@ -0,0 +1,118 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "deepEqualOnError"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects reflect.DeepEqual calls on errors and values with func or time.Time parts"
+	info.Details = `reflect.DeepEqual compares unexported error state and doesn't unwrap errors.
+Values that contain functions are never deeply equal unless the functions are nil,
+time.Time values with the same instant can differ in location and monotonic clock reading.`
+	info.Before = `if reflect.DeepEqual(err, io.EOF) { ... }`
+	info.After = `if errors.Is(err, io.EOF) { ... }`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&deepEqualOnErrorChecker{ctx: ctx})
+	})
+}
+
+type deepEqualOnErrorChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *deepEqualOnErrorChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(astcast.ToSelectorExpr(call.Fun).Sel).(*types.Func)
+	if !ok || fn.FullName() != "reflect.DeepEqual" {
+		return
+	}
+	for _, arg := range call.Args {
+		if c.ctx.TypesInfo.Types[arg].IsNil() {
+			return
+		}
+	}
+
+	for _, arg := range call.Args {
+		typ := c.ctx.TypeOf(arg)
+		if c.isError(typ) {
+			c.warnError(call)
+			return
+		}
+	}
+	for _, arg := range call.Args {
+		typ := c.ctx.TypeOf(arg)
+		if part := c.findBadPart(typ, make(map[types.Type]bool)); part != "" {
+			c.warnPart(call, arg, part)
+			return
+		}
+	}
+}
+
+func (c *deepEqualOnErrorChecker) isError(typ types.Type) bool {
+	errorType := types.Universe.Lookup("error").Type()
+	if types.Identical(typ, errorType) {
+		return true
+	}
+	if _, ok := typ.Underlying().(*types.Interface); ok {
+		return false // Other interfaces can hold non-error values
+	}
+	return types.Implements(typ, errorType.Underlying().(*types.Interface))
+}
+
+// findBadPart returns a description of the typ part that
+// makes DeepEqual unreliable or empty string if there is none.
+func (c *deepEqualOnErrorChecker) findBadPart(typ types.Type, visited map[types.Type]bool) string {
+	if visited[typ] {
+		return ""
+	}
+	visited[typ] = true
+
+	if typ.String() == "time.Time" {
+		return "time.Time"
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Signature:
+		return "func"
+	case *types.Pointer:
+		return c.findBadPart(typ.Elem(), visited)
+	case *types.Slice:
+		return c.findBadPart(typ.Elem(), visited)
+	case *types.Array:
+		return c.findBadPart(typ.Elem(), visited)
+	case *types.Map:
+		return c.findBadPart(typ.Elem(), visited)
+	case *types.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			if part := c.findBadPart(typ.Field(i).Type(), visited); part != "" {
+				return part
+			}
+		}
+	}
+	return ""
+}
+
+func (c *deepEqualOnErrorChecker) warnError(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "reflect.DeepEqual on errors compares their internal state and ignores wrapping; use errors.Is")
+}
+
+func (c *deepEqualOnErrorChecker) warnPart(cause *ast.CallExpr, arg ast.Expr, part string) {
+	switch part {
+	case "func":
+		c.ctx.Warn(cause, "%s contains func values that are never deeply equal unless nil; compare fields explicitly", arg)
+	default:
+		c.ctx.Warn(cause, "%s contains %s values that should be compared with Equal method", arg, part)
+	}
+}
@@ -0,0 +1,171 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "deprecatedUsage"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects usages of deprecated functions, types, fields and constants"
+	info.Details = `An object is deprecated if its doc comment has a paragraph
+starting with "Deprecated:". Imported packages docs are read from their sources;
+usages inside the package that declares the object are ignored.`
+	info.Before = `data, err := ioutil.ReadAll(r)`
+	info.After = `data, err := io.ReadAll(r)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return &deprecatedUsageChecker{
+			ctx:   ctx,
+			fset:  token.NewFileSet(),
+			files: make(map[string]*ast.File),
+			notes: make(map[string]string),
+		}
+	})
+}
+
+type deprecatedUsageChecker struct {
+	ctx *linter.CheckerContext
+
+	// fset is used to parse dependency sources.
+	// Positions are matched by line and column, since these
+	// files have nothing to do with the ctx.FileSet.
+	fset *token.FileSet
+
+	// files caches parsed source files; nil means the file
+	// could not be parsed.
+	files map[string]*ast.File
+
+	// notes caches deprecation notes by object position string.
+	notes map[string]string
+}
+
+func (c *deprecatedUsageChecker) WalkFile(f *ast.File) {
+	var walk func(n ast.Node) bool
+	walk = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			// Report the whole selector, but don't visit Sel twice.
+			c.checkIdent(n, n.Sel)
+			ast.Inspect(n.X, walk)
+			return false
+		case *ast.Ident:
+			c.checkIdent(n, n)
+		}
+		return true
+	}
+	ast.Inspect(f, walk)
+}
+
+func (c *deprecatedUsageChecker) checkIdent(cause ast.Node, id *ast.Ident) {
+	obj := c.ctx.TypesInfo.Uses[id]
+	if obj == nil || obj.Pkg() == nil || obj.Pkg() == c.ctx.Pkg {
+		return
+	}
+	switch obj.(type) {
+	case *types.Func, *types.TypeName, *types.Var, *types.Const:
+	default:
+		return
+	}
+	if note := c.deprecationNote(obj); note != "" {
+		c.warn(cause, note)
+	}
+}
+
+// deprecationNote returns the text of the "Deprecated:" paragraph
+// of the obj doc comment or empty string if there is none.
+func (c *deprecatedUsageChecker) deprecationNote(obj types.Object) string {
+	pos := c.ctx.FileSet.Position(obj.Pos())
+	if !pos.IsValid() {
+		return ""
+	}
+	key := pos.String()
+	if note, ok := c.notes[key]; ok {
+		return note
+	}
+	note := ""
+	if doc := c.findDoc(pos); doc != nil {
+		note = parseDeprecationNote(doc.Text())
+	}
+	c.notes[key] = note
+	return note
+}
+
+func (c *deprecatedUsageChecker) findDoc(pos token.Position) *ast.CommentGroup {
+	f, ok := c.files[pos.Filename]
+	if !ok {
+		f, _ = parser.ParseFile(c.fset, pos.Filename, nil, parser.ParseComments)
+		c.files[pos.Filename] = f
+	}
+	if f == nil {
+		return nil
+	}
+
+	var doc *ast.CommentGroup
+	matches := func(id *ast.Ident) bool {
+		p := c.fset.Position(id.Pos())
+		return p.Line == pos.Line && p.Column == pos.Column
+	}
+	// docOr returns the first non-nil doc comment.
+	docOr := func(docs ...*ast.CommentGroup) *ast.CommentGroup {
+		for _, d := range docs {
+			if d != nil {
+				return d
+			}
+		}
+		return nil
+	}
+	var genDecl *ast.GenDecl
+	ast.Inspect(f, func(n ast.Node) bool {
+		if doc != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.GenDecl:
+			genDecl = n
+		case *ast.FuncDecl:
+			if matches(n.Name) {
+				doc = n.Doc
+			}
+		case *ast.TypeSpec:
+			if matches(n.Name) {
+				doc = docOr(n.Doc, genDecl.Doc)
+			}
+		case *ast.ValueSpec:
+			for _, id := range n.Names {
+				if matches(id) {
+					doc = docOr(n.Doc, genDecl.Doc)
+				}
+			}
+		case *ast.Field:
+			for _, id := range n.Names {
+				if matches(id) {
+					doc = n.Doc
+				}
+			}
+		}
+		return true
+	})
+	return doc
+}
+
+func parseDeprecationNote(doc string) string {
+	for _, paragraph := range strings.Split(doc, "\n\n") {
+		if strings.HasPrefix(paragraph, "Deprecated: ") {
+			note := strings.TrimPrefix(paragraph, "Deprecated: ")
+			return strings.Join(strings.Fields(note), " ")
+		}
+	}
+	return ""
+}
+
+func (c *deprecatedUsageChecker) warn(cause ast.Node, note string) {
+	c.ctx.Warn(cause, "%s is deprecated: %s", cause, note)
+}
@@ -9,12 +9,19 @@ import (
 	"github.com/go-critic/go-critic/framework/linter"
 	"github.com/go-toolsmith/astequal"
 	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
 	var info linter.CheckerInfo
 	info.Name = "dupSubExpr"
 	info.Tags = []string{"diagnostic"}
+	info.Params = linter.CheckerParams{
+		"strict": {
+			Value: false,
+			Usage: "whether to skip all expressions with function calls, even the pure ones",
+		},
+	}
 	info.Summary = "Detects suspicious duplicated sub-expressions"
 	info.Before = `
 sort.Slice(xs, func(i, j int) bool {
@@ -24,9 +31,13 @@ sort.Slice(xs, func(i, j int) bool {
 sort.Slice(xs, func(i, j int) bool {
 	return xs[i].v < xs[j].v
 })`
+	info.Note = `
+Calls of builtins like len and functions from packages like strings and math
+are considered pure; other calls, like rand.Int(), can return different results.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		c := &dupSubExprChecker{ctx: ctx}
+		c.strict = info.Params.Bool("strict")
 
 		ops := []struct {
 			op    token.Token
@@ -72,6 +83,21 @@ type dupSubExprChecker struct {
 	opSet map[token.Token]bool
 
 	floatOpsSet map[token.Token]bool
+
+	strict bool
+}
+
+// dupSubExprPurePkgs is a set of packages which functions
+// have no side effects and return the same results for the same args.
+var dupSubExprPurePkgs = map[string]bool{
+	"bytes":        true,
+	"math":         true,
+	"math/bits":    true,
+	"path":         true,
+	"strconv":      true,
+	"strings":      true,
+	"unicode":      true,
+	"unicode/utf8": true,
 }
 
 func (c *dupSubExprChecker) VisitExpr(expr ast.Expr) {
@@ -87,11 +113,62 @@ func (c *dupSubExprChecker) checkBinaryExpr(expr *ast.BinaryExpr) {
 	if c.resultIsFloat(expr.X) && c.floatOpsSet[expr.Op] {
 		return
 	}
-	if typep.SideEffectFree(c.ctx.TypesInfo, expr) && c.opSet[expr.Op] && astequal.Expr(expr.X, expr.Y) {
+	if c.isPure(expr) && c.opSet[expr.Op] && astequal.Expr(expr.X, expr.Y) {
 		c.warn(expr)
 	}
 }
 
+// isPure reports whether expr evaluates to the same value every time.
+// Like typep.SideEffectFree, but permits calls of pure functions.
+func (c *dupSubExprChecker) isPure(expr ast.Expr) bool {
+	if c.strict {
+		return typep.SideEffectFree(c.ctx.TypesInfo, expr)
+	}
+	pure := true
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			pure = false
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				pure = false
+			}
+		case *ast.CallExpr:
+			if !c.isPureCall(n) {
+				pure = false
+			}
+		}
+		return pure
+	})
+	return pure
+}
+
+func (c *dupSubExprChecker) isPureCall(call *ast.CallExpr) bool {
+	if typep.IsTypeExpr(c.ctx.TypesInfo, call.Fun) {
+		return true // Conversion
+	}
+	var id *ast.Ident
+	switch fn := astutil.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fn
+	case *ast.SelectorExpr:
+		id = fn.Sel
+	default:
+		return false
+	}
+	switch obj := c.ctx.TypesInfo.ObjectOf(id).(type) {
+	case *types.Builtin:
+		switch obj.Name() {
+		case "len", "cap", "real", "imag", "complex", "min", "max":
+			return true
+		}
+	case *types.Func:
+		sig := obj.Type().(*types.Signature)
+		return sig.Recv() == nil && obj.Pkg() != nil && dupSubExprPurePkgs[obj.Pkg().Path()]
+	}
+	return false
+}
+
 func (c *dupSubExprChecker) resultIsFloat(expr ast.Expr) bool {
 	typ, ok := c.ctx.TypeOf(expr).(*types.Basic)
 	return ok && typ.Info()&types.IsFloat != 0
@@ -0,0 +1,141 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "durationArithmetic"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects suspicious time.Duration arithmetic and comparisons"
+	info.Details = `Reports Duration values multiplied by time units (they're scaled twice),
+products of two durations converted from plain numbers and Duration
+comparisons against non-zero integer literals that mean nanoseconds.`
+	info.Before = `
+timeout := cfg.Timeout * time.Second // Timeout is time.Duration
+if elapsed > 5 { ... }`
+	info.After = `
+timeout := cfg.Timeout
+if elapsed > 5*time.Second { ... }`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&durationArithmeticChecker{ctx: ctx})
+	})
+}
+
+type durationArithmeticChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *durationArithmeticChecker) VisitExpr(expr ast.Expr) {
+	e, ok := expr.(*ast.BinaryExpr)
+	if !ok {
+		return
+	}
+	x := astutil.Unparen(e.X)
+	y := astutil.Unparen(e.Y)
+	switch e.Op {
+	case token.MUL:
+		if !c.isDuration(x) || !c.isDuration(y) || c.isConst(e) {
+			return
+		}
+		c.checkMul(e, x, y)
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		switch {
+		case c.isDuration(x) && c.isBareInt(y):
+			c.warnBareInt(e, x, y)
+		case c.isDuration(y) && c.isBareInt(x):
+			c.warnBareInt(e, y, x)
+		}
+	}
+}
+
+func (c *durationArithmeticChecker) checkMul(e *ast.BinaryExpr, x, y ast.Expr) {
+	switch {
+	case c.isUnit(y) && c.isDurationValue(x):
+		c.warnScaledTwice(e, x, y)
+	case c.isUnit(x) && c.isDurationValue(y):
+		c.warnScaledTwice(e, y, x)
+	case c.isNumberConversion(x) && c.isNumberConversion(y):
+		c.warnConvertedProduct(e)
+	}
+}
+
+// isUnit reports whether x is a constant expression that
+// refers to a typed time.Duration constant, like 2*time.Second.
+func (c *durationArithmeticChecker) isUnit(x ast.Expr) bool {
+	if !c.isConst(x) {
+		return false
+	}
+	found := false
+	ast.Inspect(x, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return !found
+		}
+		if k, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Const); ok && c.isDurationType(k.Type()) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// isDurationValue reports whether x is a non-constant Duration
+// that is not converted from a plain number.
+func (c *durationArithmeticChecker) isDurationValue(x ast.Expr) bool {
+	return !c.isConst(x) && !c.isNumberConversion(x)
+}
+
+// isNumberConversion reports whether x is time.Duration(n)
+// conversion of non-constant non-Duration n.
+func (c *durationArithmeticChecker) isNumberConversion(x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !c.ctx.TypesInfo.Types[call.Fun].IsType() {
+		return false
+	}
+	arg := call.Args[0]
+	return !c.isConst(arg) && !c.isDuration(arg)
+}
+
+func (c *durationArithmeticChecker) isBareInt(x ast.Expr) bool {
+	lit, ok := x.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return false
+	}
+	v := c.ctx.TypesInfo.Types[x].Value
+	return v != nil && constant.Sign(v) != 0
+}
+
+func (c *durationArithmeticChecker) isConst(x ast.Expr) bool {
+	return c.ctx.TypesInfo.Types[x].Value != nil
+}
+
+func (c *durationArithmeticChecker) isDuration(x ast.Expr) bool {
+	return c.isDurationType(c.ctx.TypeOf(x))
+}
+
+func (c *durationArithmeticChecker) isDurationType(typ types.Type) bool {
+	return typ != nil && typ.String() == "time.Duration"
+}
+
+func (c *durationArithmeticChecker) warnScaledTwice(cause ast.Node, d, unit ast.Expr) {
+	c.ctx.Warn(cause, "%s is already a time.Duration; multiplying it by %s scales it twice", d, unit)
+}
+
+func (c *durationArithmeticChecker) warnConvertedProduct(cause *ast.BinaryExpr) {
+	c.ctx.Warn(cause, "product of two durations %s and %s has no meaningful unit", cause.X, cause.Y)
+}
+
+func (c *durationArithmeticChecker) warnBareInt(cause ast.Node, d, lit ast.Expr) {
+	c.ctx.Warn(cause, "%s is compared with %s nanoseconds; use an explicit unit like %s*time.Second", d, lit, lit)
+}
@@ -0,0 +1,118 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "embeddedFieldShadow"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects fields and methods that shadow promoted ones of a different type"
+	info.Details = `A selector resolves to the shallowest field or method, so shadowing
+a promoted member with a different type or signature silently changes
+what callers get, especially after refactors.`
+	info.Before = `
+type conn struct {
+	net.Conn
+	Close bool
+}`
+	info.After = `
+type conn struct {
+	net.Conn
+	closed bool
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return &embeddedFieldShadowChecker{ctx: ctx}
+	})
+}
+
+type embeddedFieldShadowChecker struct {
+	ctx *linter.CheckerContext
+}
+
+func (c *embeddedFieldShadowChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			c.checkMethod(decl)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					c.checkFields(spec)
+				}
+			}
+		}
+	}
+}
+
+func (c *embeddedFieldShadowChecker) checkFields(spec *ast.TypeSpec) {
+	expr, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return
+	}
+	typ, ok := c.ctx.TypeOf(expr).(*types.Struct)
+	if !ok {
+		return
+	}
+	for _, field := range expr.Fields.List {
+		for _, id := range field.Names {
+			if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil && id.Name != "_" {
+				c.checkMember(typ, id, obj)
+			}
+		}
+	}
+}
+
+func (c *embeddedFieldShadowChecker) checkMethod(decl *ast.FuncDecl) {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	recv := fn.Type().(*types.Signature).Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	typ, ok := recv.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	c.checkMember(typ, decl.Name, fn)
+}
+
+// checkMember reports obj of the typ struct if it shadows
+// a promoted member of some embedded field with a different type.
+func (c *embeddedFieldShadowChecker) checkMember(typ *types.Struct, id *ast.Ident, obj types.Object) {
+	for i := 0; i < typ.NumFields(); i++ {
+		embedded := typ.Field(i)
+		if !embedded.Embedded() {
+			continue
+		}
+		promoted, _, _ := types.LookupFieldOrMethod(embedded.Type(), true, obj.Pkg(), obj.Name())
+		if promoted == nil {
+			continue
+		}
+		// Signature identity ignores the receivers.
+		if types.Identical(promoted.Type(), obj.Type()) {
+			continue
+		}
+		c.warn(id, obj, embedded)
+		return
+	}
+}
+
+func (c *embeddedFieldShadowChecker) warn(id *ast.Ident, obj types.Object, embedded *types.Var) {
+	kind := "field"
+	if _, ok := obj.(*types.Func); ok {
+		kind = "method"
+	}
+	c.ctx.Warn(id, "%s %s shadows promoted %s.%s of a different type",
+		kind, id, embedded.Name(), id)
+}
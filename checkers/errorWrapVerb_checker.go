@@ -0,0 +1,110 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errorWrapVerb"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects fmt.Errorf calls that format errors without wrapping them"
+	info.Details = "Also reports multiple %w verbs inside a single format string for Go versions older than 1.20."
+	info.Before = `fmt.Errorf("read config: %v", err)`
+	info.After = `fmt.Errorf("read config: %w", err)`
+	info.Note = "To format an error without wrapping it intentionally, pass err.Error() as an argument."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&errorWrapVerbChecker{ctx: ctx})
+	})
+}
+
+type errorWrapVerbChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *errorWrapVerbChecker) VisitExpr(expr ast.Expr) {
+	call := astcast.ToCallExpr(expr)
+	if len(call.Args) == 0 || qualifiedName(call.Fun) != "fmt.Errorf" {
+		return
+	}
+	if call.Ellipsis.IsValid() {
+		return
+	}
+	tv := c.ctx.TypesInfo.Types[call.Args[0]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	verbs := parseFormatVerbs(constant.StringVal(tv.Value))
+	if verbs == nil {
+		return
+	}
+
+	args := call.Args[1:]
+	wrapVerbs := 0
+	for i, verb := range verbs {
+		if verb == 'w' {
+			wrapVerbs++
+		}
+		if i >= len(args) {
+			break
+		}
+		if (verb == 'v' || verb == 's') && c.isError(args[i]) {
+			c.warnNonWrapping(args[i], verb)
+		}
+	}
+
+	if wrapVerbs > 1 && !c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 20}) {
+		c.warnMultipleWrap(call)
+	}
+}
+
+func (c *errorWrapVerbChecker) isError(x ast.Expr) bool {
+	errorIface := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	return types.Implements(c.ctx.TypeOf(x), errorIface)
+}
+
+// parseFormatVerbs returns verbs that consume the format arguments, in order.
+// Returns nil if format contains explicit argument indexes or `*`
+// width/precision, so verbs can't be mapped to arguments trivially.
+func parseFormatVerbs(format string) []rune {
+	verbs := []rune{}
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		// Skip flags, width and precision.
+		for i < len(format) && strings.IndexByte("+-# 0123456789.", format[i]) != -1 {
+			i++
+		}
+		if i >= len(format) {
+			break
+		}
+		switch format[i] {
+		case '%':
+			continue
+		case '[', '*':
+			return nil
+		}
+		verbs = append(verbs, rune(format[i]))
+	}
+	return verbs
+}
+
+func (c *errorWrapVerbChecker) warnNonWrapping(arg ast.Expr, verb rune) {
+	c.ctx.Warn(arg, "use %%w instead of %%%c to wrap %s error", verb, arg)
+}
+
+func (c *errorWrapVerbChecker) warnMultipleWrap(call *ast.CallExpr) {
+	c.ctx.Warn(call, "multiple %%w verbs are not supported before Go 1.20 (current version is %s)",
+		c.ctx.GoVersion)
+}
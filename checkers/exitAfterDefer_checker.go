@@ -2,6 +2,8 @@ package checkers
 
 import (
 	"go/ast"
+	"go/types"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
@@ -14,6 +16,12 @@ func init() {
 	var info linter.CheckerInfo
 	info.Name = "exitAfterDefer"
 	info.Tags = []string{"diagnostic"}
+	info.Params = linter.CheckerParams{
+		"exitFuncs": {
+			Value: "",
+			Usage: "comma-separated list of additional exit-like funcs, like pkg/path.Fatal or (*pkg/path.Logger).Fatal",
+		},
+	}
 	info.Summary = "Detects calls to exit/fatal inside functions that use defer"
 	info.Before = `
 defer os.Remove(filename)
@@ -28,13 +36,25 @@ if bad {
 }`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
-		return astwalk.WalkerForFuncDecl(&exitAfterDeferChecker{ctx: ctx})
+		c := &exitAfterDeferChecker{
+			ctx:       ctx,
+			exitFuncs: make(map[string]bool),
+		}
+		for _, name := range strings.Split(info.Params.String("exitFuncs"), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				c.exitFuncs[name] = true
+			}
+		}
+		return astwalk.WalkerForFuncDecl(c)
 	})
 }
 
 type exitAfterDeferChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
+
+	// exitFuncs is a set of user-defined exit funcs full names.
+	exitFuncs map[string]bool
 }
 
 func (c *exitAfterDeferChecker) VisitFuncDecl(fn *ast.FuncDecl) {
@@ -52,12 +72,9 @@ func (c *exitAfterDeferChecker) VisitFuncDecl(fn *ast.FuncDecl) {
 		case *ast.DeferStmt:
 			deferStmt = n
 		case *ast.CallExpr:
-			if deferStmt != nil {
-				switch qualifiedName(n.Fun) {
-				case "log.Fatal", "log.Fatalf", "log.Fatalln", "os.Exit":
-					c.warn(n, deferStmt)
-					return false
-				}
+			if deferStmt != nil && c.isExitCall(n) {
+				c.warn(n, deferStmt)
+				return false
 			}
 		}
 		return true
@@ -65,6 +82,27 @@ func (c *exitAfterDeferChecker) VisitFuncDecl(fn *ast.FuncDecl) {
 	astutil.Apply(fn.Body, pre, post)
 }
 
+func (c *exitAfterDeferChecker) isExitCall(call *ast.CallExpr) bool {
+	switch qualifiedName(call.Fun) {
+	case "log.Fatal", "log.Fatalf", "log.Fatalln", "os.Exit":
+		return true
+	}
+	if len(c.exitFuncs) == 0 {
+		return false
+	}
+	var id *ast.Ident
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		id = fn
+	case *ast.SelectorExpr:
+		id = fn.Sel
+	default:
+		return false
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Func)
+	return ok && c.exitFuncs[fn.FullName()]
+}
+
 func (c *exitAfterDeferChecker) warn(cause *ast.CallExpr, deferStmt *ast.DeferStmt) {
 	s := astfmt.Sprint(deferStmt)
 	if fnlit, ok := deferStmt.Call.Fun.(*ast.FuncLit); ok {
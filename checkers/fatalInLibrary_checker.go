@@ -0,0 +1,102 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "fatalInLibrary"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowPkgs": {
+			Value: "cmd,cli,command,commands",
+			Usage: "comma-separated list of package path elements where process termination is allowed",
+		},
+	}
+	info.Summary = "Detects log.Fatal and os.Exit calls in non-main packages"
+	info.Details = `Terminating the process from a library prevents callers from
+handling the failure and skips deferred cleanups.
+Packages that implement command line commands (like cobra commands) can be allowed.`
+	info.Before = `
+func Load(path string) *Config {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	...
+}`
+	info.After = `
+func Load(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	...
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &fatalInLibraryChecker{
+			ctx:       ctx,
+			allowPkgs: make(map[string]bool),
+		}
+		for _, name := range strings.Split(info.Params.String("allowPkgs"), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				c.allowPkgs[name] = true
+			}
+		}
+		return astwalk.WalkerForExpr(c)
+	})
+}
+
+type fatalInLibraryChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	allowPkgs map[string]bool
+}
+
+func (c *fatalInLibraryChecker) EnterFile(f *ast.File) bool {
+	if f.Name.Name == "main" || strings.HasSuffix(c.ctx.Filename, "_test.go") {
+		return false
+	}
+	if c.allowPkgs[f.Name.Name] {
+		return false
+	}
+	for _, elem := range strings.Split(c.ctx.Pkg.Path(), "/") {
+		if c.allowPkgs[elem] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *fatalInLibraryChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return
+	}
+	switch fn.FullName() {
+	case "log.Fatal", "log.Fatalf", "log.Fatalln",
+		"(*log.Logger).Fatal", "(*log.Logger).Fatalf", "(*log.Logger).Fatalln",
+		"os.Exit":
+		c.warn(call)
+	}
+}
+
+func (c *fatalInLibraryChecker) warn(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s in a library package terminates the program; return an error instead", cause.Fun)
+}
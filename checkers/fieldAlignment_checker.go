@@ -0,0 +1,174 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/format"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "fieldAlignment"
+	info.Tags = []string{"performance", "experimental"}
+	info.Params = linter.CheckerParams{
+		"minWastedBytes": {
+			Value: 8,
+			Usage: "min number of padding bytes that could be saved by reordering fields",
+		},
+	}
+	info.Summary = "Detects structs that would take less memory if their fields were sorted"
+	info.Details = `Fields are ordered by decreasing alignment, which minimizes padding.
+A suggested fix is provided for structs with one field per line and no field comments.`
+	info.Before = `
+type record struct {
+	flag  bool
+	id    int64
+	valid bool
+}`
+	info.After = `
+type record struct {
+	id    int64
+	flag  bool
+	valid bool
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForTypeExpr(&fieldAlignmentChecker{
+			ctx:            ctx,
+			minWastedBytes: int64(info.Params.Int("minWastedBytes")),
+		}, ctx.TypesInfo)
+	})
+}
+
+type fieldAlignmentChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	minWastedBytes int64
+
+	comments []*ast.CommentGroup
+}
+
+func (c *fieldAlignmentChecker) EnterFile(f *ast.File) bool {
+	c.comments = f.Comments
+	return true
+}
+
+func (c *fieldAlignmentChecker) VisitTypeExpr(x ast.Expr) {
+	expr, ok := x.(*ast.StructType)
+	if !ok {
+		return
+	}
+	typ, ok := c.ctx.TypeOf(expr).(*types.Struct)
+	if !ok || typ.NumFields() < 2 {
+		return
+	}
+
+	fields := make([]*types.Var, typ.NumFields())
+	order := make([]int, typ.NumFields())
+	for i := range fields {
+		fields[i] = typ.Field(i)
+		order[i] = i
+	}
+	sizes := c.ctx.SizesInfo
+	sort.SliceStable(order, func(i, j int) bool {
+		x, y := fields[order[i]].Type(), fields[order[j]].Type()
+		// Zero-sized fields go first, since a trailing one
+		// is padded to a non-zero size.
+		xZero, yZero := sizes.Sizeof(x) == 0, sizes.Sizeof(y) == 0
+		if xZero != yZero {
+			return xZero
+		}
+		if xAlign, yAlign := sizes.Alignof(x), sizes.Alignof(y); xAlign != yAlign {
+			return xAlign > yAlign
+		}
+		return sizes.Sizeof(x) > sizes.Sizeof(y)
+	})
+	sorted := make([]*types.Var, len(fields))
+	for i, k := range order {
+		sorted[i] = fields[k]
+	}
+
+	size := sizes.Sizeof(typ)
+	optimal := sizes.Sizeof(types.NewStruct(sorted, nil))
+	if size-optimal < c.minWastedBytes {
+		return
+	}
+
+	c.warn(expr, size, optimal, c.reorderFix(expr, order))
+}
+
+// reorderFix returns a fix that reorders the struct fields.
+// Only structs where each line declares a single field without
+// comments can be rewritten; for others, an empty fix is returned.
+func (c *fieldAlignmentChecker) reorderFix(expr *ast.StructType, order []int) linter.QuickFix {
+	list := expr.Fields.List
+	if len(list) != len(order) {
+		return linter.QuickFix{}
+	}
+	for _, cg := range c.comments {
+		if cg.Pos() > expr.Pos() && cg.End() < expr.End() {
+			return linter.QuickFix{}
+		}
+	}
+	first := c.ctx.FileSet.Position(list[0].Pos())
+	if first.Line == c.ctx.FileSet.Position(expr.Pos()).Line {
+		return linter.QuickFix{}
+	}
+	indent := strings.Repeat("\t", first.Column-1)
+
+	lines := make([]string, len(order))
+	for i, k := range order {
+		field := list[k]
+		var parts []string
+		for _, name := range field.Names {
+			parts = append(parts, name.Name)
+		}
+		parts = append(parts, astfmt.Sprint(field.Type))
+		if field.Tag != nil {
+			parts = append(parts, field.Tag.Value)
+		}
+		lines[i] = strings.Join(parts, " ")
+	}
+	lines, ok := c.alignFields(lines)
+	if !ok {
+		return linter.QuickFix{}
+	}
+	return linter.QuickFix{
+		From:        list[0].Pos(),
+		To:          list[len(list)-1].End(),
+		Replacement: []byte(strings.Join(lines, "\n"+indent)),
+	}
+}
+
+// alignFields aligns the field lines columns like gofmt does.
+func (c *fieldAlignmentChecker) alignFields(lines []string) ([]string, bool) {
+	src := "package p\n\ntype _ struct {\n" + strings.Join(lines, "\n") + "\n}\n"
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return nil, false
+	}
+	formattedLines := strings.Split(string(formatted), "\n")
+	// Skip the package clause and the struct header,
+	// then take exactly the field lines.
+	const header = 3
+	if len(formattedLines) < header+len(lines) {
+		return nil, false
+	}
+	aligned := make([]string, len(lines))
+	for i := range lines {
+		aligned[i] = strings.TrimPrefix(formattedLines[header+i], "\t")
+	}
+	return aligned, true
+}
+
+func (c *fieldAlignmentChecker) warn(expr *ast.StructType, size, optimal int64, fix linter.QuickFix) {
+	c.ctx.WarnFixable(expr, fix, "struct of size %d could be %d with fields reordered by alignment",
+		size, optimal)
+}
@@ -2,6 +2,9 @@ package checkers
 
 import (
 	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
 	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
@@ -13,9 +16,16 @@ func init() {
 	var info linter.CheckerInfo
 	info.Name = "filepathJoin"
 	info.Tags = []string{"diagnostic", "experimental"}
-	info.Summary = "Detects problems in filepath.Join() function calls"
-	info.Before = `filepath.Join("dir/", filename)`
-	info.After = `filepath.Join("dir", filename)`
+	info.Summary = "Detects problems in filepath.Join() function calls and paths built with \"/\""
+	info.Details = `Paths built by concatenation or fmt.Sprintf are reported if they're passed
+to os, io/ioutil or path/filepath functions (filepath.Join is suggested)
+or to net/http and net/url functions (path.Join is suggested).`
+	info.Before = `
+filepath.Join("dir/", filename)
+os.Open(dir + "/" + filename)`
+	info.After = `
+filepath.Join("dir", filename)
+os.Open(filepath.Join(dir, filename))`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		return astwalk.WalkerForExpr(&filepathJoinChecker{ctx: ctx})
@@ -30,6 +40,7 @@ type filepathJoinChecker struct {
 func (c *filepathJoinChecker) VisitExpr(expr ast.Expr) {
 	call := astcast.ToCallExpr(expr)
 	if qualifiedName(call.Fun) != "filepath.Join" {
+		c.checkPathArgs(call)
 		return
 	}
 
@@ -41,6 +52,90 @@ func (c *filepathJoinChecker) VisitExpr(expr ast.Expr) {
 	}
 }
 
+// checkPathArgs finds manually joined path arguments.
+// The kind of path is deduced from the called function package.
+func (c *filepathJoinChecker) checkPathArgs(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return
+	}
+	var join string
+	switch fn.Pkg().Path() {
+	case "os", "io/ioutil", "path/filepath":
+		join = "filepath.Join"
+	case "net/http", "net/url":
+		join = "path.Join"
+	default:
+		return
+	}
+	for _, arg := range call.Args {
+		if c.isManualJoin(arg) {
+			c.warnManualJoin(arg, join)
+		}
+	}
+}
+
+// isManualJoin reports whether x is a string concatenation or
+// fmt.Sprintf call that puts "/" between its non-constant operands.
+func (c *filepathJoinChecker) isManualJoin(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.BinaryExpr:
+		if x.Op != token.ADD {
+			return false
+		}
+		var operands []ast.Expr
+		c.flattenConcat(x, &operands)
+		for i, operand := range operands {
+			s, ok := c.stringConst(operand)
+			if !ok {
+				continue
+			}
+			hasLeft := i > 0 && !c.isConst(operands[i-1])
+			hasRight := i < len(operands)-1 && !c.isConst(operands[i+1])
+			if (hasLeft && strings.HasPrefix(s, "/")) || (hasRight && strings.HasSuffix(s, "/")) {
+				return true
+			}
+		}
+	case *ast.CallExpr:
+		sel, ok := x.Fun.(*ast.SelectorExpr)
+		if !ok || len(x.Args) < 2 {
+			return false
+		}
+		fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+		if !ok || fn.FullName() != "fmt.Sprintf" {
+			return false
+		}
+		format, ok := c.stringConst(x.Args[0])
+		return ok && (strings.Contains(format, "%s/%") || strings.Contains(format, "%v/%"))
+	}
+	return false
+}
+
+func (c *filepathJoinChecker) flattenConcat(x ast.Expr, operands *[]ast.Expr) {
+	if bin, ok := x.(*ast.BinaryExpr); ok && bin.Op == token.ADD {
+		c.flattenConcat(bin.X, operands)
+		c.flattenConcat(bin.Y, operands)
+		return
+	}
+	*operands = append(*operands, x)
+}
+
+func (c *filepathJoinChecker) stringConst(x ast.Expr) (string, bool) {
+	tv := c.ctx.TypesInfo.Types[x]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+func (c *filepathJoinChecker) isConst(x ast.Expr) bool {
+	return c.ctx.TypesInfo.Types[x].Value != nil
+}
+
 func (c *filepathJoinChecker) hasSeparator(v *ast.BasicLit) bool {
 	return strings.ContainsAny(v.Value, `/\`)
 }
@@ -48,3 +143,7 @@ func (c *filepathJoinChecker) hasSeparator(v *ast.BasicLit) bool {
 func (c *filepathJoinChecker) warnSeparator(sep ast.Expr) {
 	c.ctx.Warn(sep, "%s contains a path separator", sep)
 }
+
+func (c *filepathJoinChecker) warnManualJoin(cause ast.Expr, join string) {
+	c.ctx.Warn(cause, "use %s instead of building the path with \"/\"", join)
+}
@@ -0,0 +1,111 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "httpNoBody"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects nil request bodies for methods with payload and http.NewRequest without context"
+	info.Details = `For POST, PUT and PATCH requests http.NoBody states explicitly that
+the request has no payload. http.NewRequest is reported only in files
+that use http.NewRequestWithContext elsewhere.`
+	info.Before = `req, err := http.NewRequest(http.MethodPost, url, nil)`
+	info.After = `req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, http.NoBody)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&httpNoBodyChecker{ctx: ctx})
+	})
+}
+
+type httpNoBodyChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// usesContext is set if current file calls http.NewRequestWithContext.
+	usesContext bool
+}
+
+func (c *httpNoBodyChecker) EnterFile(f *ast.File) bool {
+	c.usesContext = false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && c.funcName(call) == "net/http.NewRequestWithContext" {
+			c.usesContext = true
+		}
+		return !c.usesContext
+	})
+	return true
+}
+
+func (c *httpNoBodyChecker) VisitExpr(x ast.Expr) {
+	call, ok := x.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	var method, body ast.Expr
+	switch c.funcName(call) {
+	case "net/http.NewRequest":
+		if len(call.Args) != 3 {
+			return
+		}
+		if c.usesContext {
+			c.warnNoContext(call)
+		}
+		method, body = call.Args[0], call.Args[2]
+	case "net/http.NewRequestWithContext":
+		if len(call.Args) != 4 {
+			return
+		}
+		method, body = call.Args[1], call.Args[3]
+	default:
+		return
+	}
+
+	if !c.isNil(body) {
+		return
+	}
+	tv := c.ctx.TypesInfo.Types[method]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	switch constant.StringVal(tv.Value) {
+	case "POST", "PUT", "PATCH":
+		c.warnNilBody(body)
+	}
+}
+
+func (c *httpNoBodyChecker) isNil(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.ctx.TypesInfo.ObjectOf(id).(*types.Nil)
+	return ok
+}
+
+func (c *httpNoBodyChecker) funcName(call *ast.CallExpr) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return ""
+	}
+	return fn.Pkg().Path() + "." + fn.Name()
+}
+
+func (c *httpNoBodyChecker) warnNilBody(cause ast.Expr) {
+	c.ctx.Warn(cause, "use http.NoBody instead of nil for requests without payload")
+}
+
+func (c *httpNoBodyChecker) warnNoContext(cause *ast.CallExpr) {
+	c.ctx.Warn(cause.Fun, "use http.NewRequestWithContext like the rest of this file")
+}
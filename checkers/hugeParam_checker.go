@@ -2,9 +2,13 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
@@ -18,6 +22,9 @@ func init() {
 		},
 	}
 	info.Summary = "Detects params that incur excessive amount of copying"
+	info.Details = `Quick fix changes params of unexported functions to pointers and updates the call sites
+when all of them are in the same file and the params are only used for reading fields.`
+	info.Note = "Quick fixes of functions with interleaved call sites overlap, so they are applied one at a time."
 	info.Before = `func f(x [1024]int) {}`
 	info.After = `func f(x *[1024]int) {}`
 
@@ -34,30 +41,271 @@ type hugeParamChecker struct {
 	ctx *linter.CheckerContext
 
 	sizeThreshold int64
+
+	file *ast.File
+}
+
+// hugeParam is a param that exceeds the size threshold.
+type hugeParam struct {
+	id    *ast.Ident
+	field *ast.Field
+	index int
+	size  int64
+}
+
+func (c *hugeParamChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
 }
 
 func (c *hugeParamChecker) VisitFuncDecl(decl *ast.FuncDecl) {
 	// TODO(quasilyte): maybe it's worthwhile to permit skipping
 	// test files for this checker?
 	if decl.Recv != nil {
-		c.checkParams(decl.Recv.List)
+		for _, p := range c.checkParams(decl.Recv.List) {
+			c.warn(p.id, p.size)
+		}
+	}
+	params := c.checkParams(decl.Type.Params.List)
+	if len(params) == 0 {
+		return
+	}
+	fix, ok := c.suggestFix(decl, params)
+	for i, p := range params {
+		if i == 0 && ok {
+			c.warnFixable(p.id, p.size, fix)
+		} else {
+			c.warn(p.id, p.size)
+		}
 	}
-	c.checkParams(decl.Type.Params.List)
 }
 
-func (c *hugeParamChecker) checkParams(params []*ast.Field) {
+func (c *hugeParamChecker) checkParams(params []*ast.Field) []hugeParam {
+	var huge []hugeParam
+	index := 0
 	for _, p := range params {
 		for _, id := range p.Names {
 			typ := c.ctx.TypeOf(id)
 			size := c.ctx.SizesInfo.Sizeof(typ)
 			if size >= c.sizeThreshold {
-				c.warn(id, size)
+				huge = append(huge, hugeParam{id: id, field: p, index: index, size: size})
 			}
+			index++
 		}
 	}
+	return huge
+}
+
+// suggestFix returns a fix that makes all params pointers and
+// takes the args addresses at the call sites.
+//
+// The fix is only suggested when the rewrite can't change the program
+// semantics or break other packages: the function must be unexported,
+// only called directly from the current file and params must be
+// used only for reading fields outside of closures.
+func (c *hugeParamChecker) suggestFix(decl *ast.FuncDecl, params []hugeParam) (linter.QuickFix, bool) {
+	if decl.Recv != nil || decl.Body == nil || ast.IsExported(decl.Name.Name) {
+		return linter.QuickFix{}, false
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return linter.QuickFix{}, false
+	}
+
+	var edits []hugeParamEdit
+	seenFields := make(map[*ast.Field]bool)
+	for _, p := range params {
+		if _, ok := p.field.Type.(*ast.Ellipsis); ok {
+			return linter.QuickFix{}, false
+		}
+		if !c.isReadOnly(decl.Body, c.ctx.TypesInfo.ObjectOf(p.id)) {
+			return linter.QuickFix{}, false
+		}
+		if !seenFields[p.field] {
+			seenFields[p.field] = true
+			edits = append(edits, hugeParamEdit{pos: p.field.Type.Pos(), text: "*"})
+		}
+	}
+
+	calls, ok := c.findCalls(fn)
+	if !ok {
+		return linter.QuickFix{}, false
+	}
+	for _, call := range calls {
+		for _, p := range params {
+			if p.index >= len(call.Args) || !c.isAddressable(call.Args[p.index]) {
+				return linter.QuickFix{}, false
+			}
+			edits = append(edits, hugeParamEdit{pos: call.Args[p.index].Pos(), text: "&"})
+		}
+	}
+
+	return c.applyEdits(edits)
+}
+
+// hugeParamEdit is a text insertion.
+type hugeParamEdit struct {
+	pos  token.Pos
+	text string
+}
+
+// applyEdits makes a single fix out of insertions
+// by rewriting the source text they span.
+func (c *hugeParamChecker) applyEdits(edits []hugeParamEdit) (linter.QuickFix, bool) {
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].pos < edits[j].pos
+	})
+	from := edits[0].pos
+	to := edits[len(edits)-1].pos
+	src := c.ctx.SourceText(from, to)
+	if src == nil {
+		return linter.QuickFix{}, false
+	}
+
+	var replacement []byte
+	prev := from
+	for _, e := range edits {
+		replacement = append(replacement, src[prev-from:e.pos-from]...)
+		replacement = append(replacement, e.text...)
+		prev = e.pos
+	}
+	fix := linter.QuickFix{
+		From:        from,
+		To:          to,
+		Replacement: replacement,
+	}
+	return fix, true
+}
+
+// findCalls returns all fn calls. Reports false if fn is used
+// in other files, as a value or in go and defer statements.
+func (c *hugeParamChecker) findCalls(fn *types.Func) ([]*ast.CallExpr, bool) {
+	filename := c.ctx.FileSet.Position(c.file.Pos()).Filename
+	uses := 0
+	for id, obj := range c.ctx.TypesInfo.Uses {
+		if obj != fn {
+			continue
+		}
+		if c.ctx.FileSet.Position(id.Pos()).Filename != filename {
+			return nil, false
+		}
+		uses++
+	}
+
+	var calls []*ast.CallExpr
+	ok := true
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			ok = ok && !c.isCallOf(n.Call, fn)
+		case *ast.DeferStmt:
+			ok = ok && !c.isCallOf(n.Call, fn)
+		case *ast.CallExpr:
+			if c.isCallOf(n, fn) {
+				calls = append(calls, n)
+			}
+		}
+		return ok
+	})
+	return calls, ok && len(calls) == uses
+}
+
+func (c *hugeParamChecker) isCallOf(call *ast.CallExpr, fn *types.Func) bool {
+	id, ok := astutil.Unparen(call.Fun).(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(id) == fn
+}
+
+// isReadOnly reports whether all obj usages inside body are field
+// reads or value receiver method calls outside of function literals.
+// See isReadOnlyCopyUse for the selector chains that are rejected.
+func (c *hugeParamChecker) isReadOnly(body *ast.BlockStmt, obj types.Object) bool {
+	ok := true
+	var parents []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			parents = parents[:len(parents)-1]
+			return true
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			ast.Inspect(n, func(x ast.Node) bool {
+				if id, isIdent := x.(*ast.Ident); isIdent && c.ctx.TypesInfo.ObjectOf(id) == obj {
+					ok = false
+				}
+				return ok
+			})
+		case *ast.Ident:
+			if c.ctx.TypesInfo.ObjectOf(n) == obj {
+				if !isReadOnlyCopyUse(c.ctx, parents, n) {
+					ok = false
+				}
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				ok = ok && !c.isRootedAt(lhs, obj)
+			}
+		case *ast.IncDecStmt:
+			ok = ok && !c.isRootedAt(n.X, obj)
+		case *ast.UnaryExpr:
+			ok = ok && !(n.Op == token.AND && c.isRootedAt(n.X, obj))
+		}
+		parents = append(parents, n)
+		return ok
+	})
+	return ok
+}
+
+// isRootedAt reports whether selector and index expressions chain x starts with obj.
+func (c *hugeParamChecker) isRootedAt(x ast.Expr, obj types.Object) bool {
+	for {
+		switch e := astutil.Unparen(x).(type) {
+		case *ast.Ident:
+			return c.ctx.TypesInfo.ObjectOf(e) == obj
+		case *ast.SelectorExpr:
+			x = e.X
+		case *ast.IndexExpr:
+			x = e.X
+		case *ast.SliceExpr:
+			x = e.X
+		default:
+			return false
+		}
+	}
+}
+
+// isAddressable reports whether &x is a valid expression.
+func (c *hugeParamChecker) isAddressable(x ast.Expr) bool {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.Ident:
+		_, ok := c.ctx.TypesInfo.ObjectOf(x).(*types.Var)
+		return ok
+	case *ast.CompositeLit, *ast.StarExpr:
+		return true
+	case *ast.SelectorExpr:
+		if _, ok := c.ctx.TypesInfo.ObjectOf(x.Sel).(*types.Var); !ok {
+			return false
+		}
+		if _, ok := c.ctx.TypeOf(x.X).Underlying().(*types.Pointer); ok {
+			return true
+		}
+		return c.isAddressable(x.X)
+	case *ast.IndexExpr:
+		switch c.ctx.TypeOf(x.X).Underlying().(type) {
+		case *types.Slice, *types.Pointer:
+			return true
+		case *types.Array:
+			return c.isAddressable(x.X)
+		}
+	}
+	return false
 }
 
 func (c *hugeParamChecker) warn(cause *ast.Ident, size int64) {
 	c.ctx.Warn(cause, "%s is heavy (%d bytes); consider passing it by pointer",
 		cause, size)
 }
+
+func (c *hugeParamChecker) warnFixable(cause *ast.Ident, size int64, fix linter.QuickFix) {
+	c.ctx.WarnFixable(cause, fix, "%s is heavy (%d bytes); consider passing it by pointer",
+		cause, size)
+}
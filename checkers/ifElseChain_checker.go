@@ -2,6 +2,8 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
@@ -11,6 +13,12 @@ func init() {
 	var info linter.CheckerInfo
 	info.Name = "ifElseChain"
 	info.Tags = []string{"style"}
+	info.Params = linter.CheckerParams{
+		"minThreshold": {
+			Value: 2,
+			Usage: "min number of else-if and else branches that makes the warning trigger",
+		},
+	}
 	info.Summary = "Detects repeated if-else statements and suggests to replace them with switch statement"
 	info.Before = `
 if cond1 {
@@ -32,10 +40,14 @@ default:
 	info.Note = `
 Permits single else or else-if; repeated else-if or else + else-if
 will trigger suggestion to use switch statement.
+Quick fix is not suggested for branches with break statements.
 See [EffectiveGo#switch](https://golang.org/doc/effective_go.html#switch).`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
-		return astwalk.WalkerForStmt(&ifElseChainChecker{ctx: ctx})
+		return astwalk.WalkerForStmt(&ifElseChainChecker{
+			ctx:          ctx,
+			minThreshold: info.Params.Int("minThreshold"),
+		})
 	})
 }
 
@@ -43,6 +55,8 @@ type ifElseChainChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
+	minThreshold int
+
 	cause   *ast.IfStmt
 	visited map[*ast.IfStmt]bool
 }
@@ -66,9 +80,12 @@ func (c *ifElseChainChecker) VisitStmt(stmt ast.Stmt) {
 }
 
 func (c *ifElseChainChecker) checkIfStmt(stmt *ast.IfStmt) {
-	const minThreshold = 2
-	if c.countIfelseLen(stmt) >= minThreshold {
-		c.warn()
+	if c.countIfelseLen(stmt) >= c.minThreshold {
+		if fix, ok := c.suggestSwitch(stmt); ok {
+			c.warnFixable(fix)
+		} else {
+			c.warn()
+		}
 	}
 }
 
@@ -94,6 +111,76 @@ func (c *ifElseChainChecker) countIfelseLen(stmt *ast.IfStmt) int {
 	}
 }
 
+// suggestSwitch returns a fix that rewrites if-else chain to a switch.
+// Branch bodies are copied from the source, so their comments are preserved.
+func (c *ifElseChainChecker) suggestSwitch(stmt *ast.IfStmt) (linter.QuickFix, bool) {
+	if c.ctx.SourceText(stmt.Pos(), stmt.End()) == nil {
+		return linter.QuickFix{}, false
+	}
+	text := func(from, to token.Pos) string {
+		return string(c.ctx.SourceText(from, to))
+	}
+	indent := strings.Repeat("\t", c.ctx.FileSet.Position(stmt.If).Column-1)
+
+	var buf strings.Builder
+	buf.WriteString("switch ")
+	if stmt.Init != nil {
+		buf.WriteString(text(stmt.Init.Pos(), stmt.Init.End()) + "; ")
+	}
+	buf.WriteString("{")
+	addCase := func(label string, body *ast.BlockStmt) {
+		buf.WriteString("\n" + indent + label + ":")
+		buf.WriteString(strings.TrimRight(text(body.Lbrace+1, body.Rbrace), " \t\n"))
+	}
+	for branch := stmt; ; {
+		if c.hasBreak(branch.Body) {
+			return linter.QuickFix{}, false
+		}
+		addCase("case "+text(branch.Cond.Pos(), branch.Cond.End()), branch.Body)
+		if next, ok := branch.Else.(*ast.IfStmt); ok {
+			branch = next
+			continue
+		}
+		if body, ok := branch.Else.(*ast.BlockStmt); ok {
+			if c.hasBreak(body) {
+				return linter.QuickFix{}, false
+			}
+			addCase("default", body)
+		}
+		break
+	}
+	buf.WriteString("\n" + indent + "}")
+
+	fix := linter.QuickFix{
+		From:        stmt.Pos(),
+		To:          stmt.End(),
+		Replacement: []byte(buf.String()),
+	}
+	return fix, true
+}
+
+// hasBreak reports whether body contains unlabeled break statements
+// that would break out of the switch after the rewrite.
+func (c *ifElseChainChecker) hasBreak(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt, *ast.FuncLit:
+			return false
+		case *ast.BranchStmt:
+			if n.Tok == token.BREAK && n.Label == nil {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
 func (c *ifElseChainChecker) warn() {
 	c.ctx.Warn(c.cause, "rewrite if-else to switch statement")
 }
+
+func (c *ifElseChainChecker) warnFixable(fix linter.QuickFix) {
+	c.ctx.WarnFixable(c.cause, fix, "rewrite if-else to switch statement")
+}
@@ -0,0 +1,153 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "insecureCompare"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"patterns": {
+			Value: "secret,token,password,passwd,mac,hmac,signature,sig,digest,apikey,nonce",
+			Usage: "comma-separated list of identifier words that denote secret values",
+		},
+	}
+	info.Summary = "Detects non-constant-time comparisons of secrets, tokens and MACs"
+	info.Details = `String equality and bytes.Equal return as soon as a byte differs,
+so the comparison time leaks how much of the secret was guessed correctly.
+Identifiers are split into camelCase and snake_case words that are matched against patterns.`
+	info.Before = `
+if r.Header.Get("X-Token") == apiToken {
+	serve(w, r)
+}`
+	info.After = `
+if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Token")), []byte(apiToken)) == 1 {
+	serve(w, r)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &insecureCompareChecker{
+			ctx:      ctx,
+			patterns: make(map[string]bool),
+		}
+		for _, p := range strings.Split(info.Params.String("patterns"), ",") {
+			if p = strings.ToLower(strings.TrimSpace(p)); p != "" {
+				c.patterns[p] = true
+			}
+		}
+		return astwalk.WalkerForExpr(c)
+	})
+}
+
+type insecureCompareChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	patterns map[string]bool
+}
+
+func (c *insecureCompareChecker) VisitExpr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op != token.EQL && expr.Op != token.NEQ {
+			return
+		}
+		if !typep.HasStringProp(c.ctx.TypeOf(expr.X).Underlying()) {
+			return
+		}
+		c.checkOperands(expr, expr.X, expr.Y)
+	case *ast.CallExpr:
+		fn, ok := c.ctx.TypesInfo.ObjectOf(astcast.ToSelectorExpr(expr.Fun).Sel).(*types.Func)
+		if !ok || fn.FullName() != "bytes.Equal" || len(expr.Args) != 2 {
+			return
+		}
+		c.checkOperands(expr, expr.Args[0], expr.Args[1])
+	}
+}
+
+func (c *insecureCompareChecker) checkOperands(cmp, x, y ast.Expr) {
+	if c.isConstOrNil(x) || c.isConstOrNil(y) {
+		return // Emptiness or well-known value checks
+	}
+	for _, operand := range []ast.Expr{x, y} {
+		if name := c.secretName(operand); name != "" {
+			c.warn(cmp, name)
+			return
+		}
+	}
+}
+
+// secretName returns the operand identifier name if it looks like a secret.
+func (c *insecureCompareChecker) secretName(x ast.Expr) string {
+	id := identOf(x)
+	if call := astcast.ToCallExpr(x); len(call.Args) == 1 && c.ctx.TypesInfo.Types[call.Fun].IsType() {
+		id = identOf(call.Args[0]) // []byte(token) and string(token)
+	}
+	if id == nil || !c.isSecretIdent(id.Name) {
+		return ""
+	}
+	return id.Name
+}
+
+func (c *insecureCompareChecker) isSecretIdent(name string) bool {
+	words := splitIdentWords(name)
+	for i, w := range words {
+		if c.patterns[w] {
+			return true
+		}
+		if i+1 < len(words) && c.patterns[w+words[i+1]] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *insecureCompareChecker) isConstOrNil(x ast.Expr) bool {
+	tv := c.ctx.TypesInfo.Types[x]
+	return tv.Value != nil || tv.IsNil()
+}
+
+func (c *insecureCompareChecker) warn(cause ast.Expr, name string) {
+	c.ctx.Warn(cause, "comparison of %s is not constant-time; use subtle.ConstantTimeCompare", name)
+}
+
+// splitIdentWords splits a camelCase or snake_case identifier
+// into lowercased words. Acronyms are kept together, so "HTTPToken"
+// becomes "http" and "token".
+func splitIdentWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	flush := func(end int) {
+		if end > start {
+			words = append(words, strings.ToLower(string(runes[start:end])))
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case r == '_':
+			flush(i)
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				flush(i)
+				start = i
+			}
+		}
+	}
+	flush(len(runes))
+	return words
+}
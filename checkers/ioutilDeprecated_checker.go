@@ -0,0 +1,199 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "ioutilDeprecated"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects io/ioutil usages that can be replaced with io and os functions"
+	info.Details = `io/ioutil is deprecated since Go 1.16. Quick fixes rewrite the calls
+and the import declaration, unless the file uses ioutil.ReadDir that
+returns a different type than os.ReadDir.`
+	info.Before = `data, err := ioutil.ReadFile(filename)`
+	info.After = `data, err := os.ReadFile(filename)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return &ioutilDeprecatedChecker{ctx: ctx}
+	})
+}
+
+type ioutilDeprecatedChecker struct {
+	ctx *linter.CheckerContext
+}
+
+// ioutilReplacements maps io/ioutil symbols to their replacements.
+// Empty package path means there is no drop-in replacement.
+var ioutilReplacements = map[string]struct{ pkgPath, name string }{
+	"Discard":   {"io", "Discard"},
+	"NopCloser": {"io", "NopCloser"},
+	"ReadAll":   {"io", "ReadAll"},
+	"ReadDir":   {"", "os.ReadDir"},
+	"ReadFile":  {"os", "ReadFile"},
+	"TempDir":   {"os", "MkdirTemp"},
+	"TempFile":  {"os", "CreateTemp"},
+	"WriteFile": {"os", "WriteFile"},
+}
+
+func (c *ioutilDeprecatedChecker) WalkFile(f *ast.File) {
+	if !c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 16}) {
+		return
+	}
+	spec := c.ioutilImport(f)
+	if spec == nil {
+		return
+	}
+
+	var uses []*ast.SelectorExpr
+	fixable := true
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok || !c.isIoutil(sel.X) {
+			return true
+		}
+		uses = append(uses, sel)
+		if ioutilReplacements[sel.Sel.Name].pkgPath == "" {
+			fixable = false
+		}
+		return false
+	})
+
+	// Package names to use in replacements; the missing
+	// ones are added to the import declaration.
+	pkgNames := make(map[string]string)
+	var missing []string
+	for _, sel := range uses {
+		pkgPath := ioutilReplacements[sel.Sel.Name].pkgPath
+		if pkgPath == "" || pkgNames[pkgPath] != "" {
+			continue
+		}
+		name := importedPkgName(f, pkgPath)
+		if name == "" {
+			name = pkgPath
+			missing = append(missing, pkgPath)
+		}
+		pkgNames[pkgPath] = name
+	}
+
+	sort.Strings(missing)
+
+	for _, sel := range uses {
+		repl := ioutilReplacements[sel.Sel.Name]
+		switch {
+		case repl.pkgPath == "":
+			c.warnNoFix(sel, repl.name)
+		case !fixable:
+			c.warnNoFix(sel, repl.pkgPath+"."+repl.name)
+		default:
+			c.warnFix(sel, pkgNames[repl.pkgPath]+"."+repl.name)
+		}
+	}
+	if fixable && len(uses) != 0 {
+		c.warnImport(f, spec, missing)
+	}
+}
+
+func (c *ioutilDeprecatedChecker) ioutilImport(f *ast.File) *ast.ImportSpec {
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != "io/ioutil" {
+			continue
+		}
+		if spec.Name != nil && (spec.Name.Name == "_" || spec.Name.Name == ".") {
+			return nil
+		}
+		return spec
+	}
+	return nil
+}
+
+func (c *ioutilDeprecatedChecker) isIoutil(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkg, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.PkgName)
+	return ok && pkg.Imported().Path() == "io/ioutil"
+}
+
+// importDecl returns a declaration that contains spec.
+func (c *ioutilDeprecatedChecker) importDecl(f *ast.File, spec *ast.ImportSpec) *ast.GenDecl {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.IMPORT {
+			continue
+		}
+		for _, s := range decl.Specs {
+			if s == spec {
+				return decl
+			}
+		}
+	}
+	return nil
+}
+
+// lineBounds returns the start of the line that contains from
+// and the start of the line that follows the line that contains to.
+func (c *ioutilDeprecatedChecker) lineBounds(from, to token.Pos) (token.Pos, token.Pos) {
+	file := c.ctx.FileSet.File(from)
+	begin := file.LineStart(file.Line(from))
+	endLine := file.Line(to) + 1
+	if endLine > file.LineCount() {
+		return begin, to
+	}
+	return begin, file.LineStart(endLine)
+}
+
+func (c *ioutilDeprecatedChecker) warnImport(f *ast.File, spec *ast.ImportSpec, missing []string) {
+	decl := c.importDecl(f, spec)
+	if decl == nil {
+		return
+	}
+
+	quoted := make([]string, len(missing))
+	for i, path := range missing {
+		quoted[i] = strconv.Quote(path)
+	}
+
+	var fix linter.QuickFix
+	switch {
+	case decl.Lparen.IsValid() && len(missing) == 0:
+		fix.From, fix.To = c.lineBounds(spec.Pos(), spec.End())
+		fix.Replacement = []byte{}
+	case decl.Lparen.IsValid():
+		fix.From, fix.To = spec.Pos(), spec.End()
+		fix.Replacement = []byte(strings.Join(quoted, "\n\t"))
+	case len(missing) == 0:
+		fix.From, fix.To = c.lineBounds(decl.Pos(), decl.End())
+		fix.Replacement = []byte{}
+	case len(missing) == 1:
+		fix.From, fix.To = spec.Pos(), spec.End()
+		fix.Replacement = []byte(quoted[0])
+	default:
+		fix.From, fix.To = decl.Pos(), decl.End()
+		fix.Replacement = []byte("import (\n\t" + strings.Join(quoted, "\n\t") + "\n)")
+	}
+	c.ctx.WarnFixable(spec, fix, "io/ioutil package is deprecated; use io and os packages instead")
+}
+
+func (c *ioutilDeprecatedChecker) warnFix(sel *ast.SelectorExpr, suggestion string) {
+	fix := linter.QuickFix{
+		From:        sel.Pos(),
+		To:          sel.End(),
+		Replacement: []byte(suggestion),
+	}
+	c.ctx.WarnFixable(sel, fix, "%s is deprecated; use %s", sel, suggestion)
+}
+
+func (c *ioutilDeprecatedChecker) warnNoFix(sel *ast.SelectorExpr, suggestion string) {
+	c.ctx.Warn(sel, "%s is deprecated; use %s", sel, suggestion)
+}
@@ -0,0 +1,169 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "jsonTagNaming"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"naming": {
+			Value: "auto",
+			Usage: "camel, snake or auto to follow the naming used by most package-level types",
+		},
+		"checkYaml": {
+			Value: false,
+			Usage: "whether to check yaml tags as well",
+		},
+	}
+	info.Summary = "Detects json tag names that don't follow the package naming convention"
+	info.Before = "type user struct {\n\tFirstName string `json:\"first_name\"`\n\tLastName  string `json:\"lastName\"`\n}"
+	info.After = "type user struct {\n\tFirstName string `json:\"first_name\"`\n\tLastName  string `json:\"last_name\"`\n}"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &jsonTagNamingChecker{
+			ctx:    ctx,
+			naming: info.Params.String("naming"),
+			keys:   []string{"json"},
+		}
+		switch c.naming {
+		case "camel", "snake", "auto":
+		default:
+			panic(fmt.Sprintf("jsonTagNaming: invalid naming param value %q: expected camel, snake or auto", c.naming))
+		}
+		if info.Params.Bool("checkYaml") {
+			c.keys = append(c.keys, "yaml")
+		}
+		return astwalk.WalkerForTypeExpr(c, ctx.TypesInfo)
+	})
+}
+
+type jsonTagNamingChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	naming string
+	keys   []string
+
+	// pkg is a package for which the auto naming was inferred.
+	pkg        *types.Package
+	autoNaming string
+}
+
+func (c *jsonTagNamingChecker) EnterFile(f *ast.File) bool {
+	if c.naming != "auto" {
+		return true
+	}
+	if c.pkg != c.ctx.Pkg {
+		c.pkg = c.ctx.Pkg
+		c.autoNaming = c.inferNaming()
+	}
+	return c.autoNaming != ""
+}
+
+func (c *jsonTagNamingChecker) VisitTypeExpr(x ast.Expr) {
+	typ, ok := x.(*ast.StructType)
+	if !ok {
+		return
+	}
+	naming := c.naming
+	if naming == "auto" {
+		naming = c.autoNaming
+	}
+	for _, field := range typ.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		for _, key := range c.keys {
+			name := c.tagName(reflect.StructTag(tag), key)
+			if name != "" && !c.matches(name, naming) {
+				c.warn(field, key, name, naming)
+			}
+		}
+	}
+}
+
+// inferNaming returns the naming convention used by most tags
+// of the package-level struct types.
+// Returns empty string if there is no clear winner.
+func (c *jsonTagNamingChecker) inferNaming() string {
+	camel, snake := 0, 0
+	scope := c.ctx.Pkg.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		typ, ok := typeName.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		for i := 0; i < typ.NumFields(); i++ {
+			for _, key := range c.keys {
+				name := c.tagName(reflect.StructTag(typ.Tag(i)), key)
+				isCamel, isSnake := c.matches(name, "camel"), c.matches(name, "snake")
+				switch {
+				case isCamel && !isSnake:
+					camel++
+				case isSnake && !isCamel:
+					snake++
+				}
+			}
+		}
+	}
+	switch {
+	case camel > snake:
+		return "camel"
+	case snake > camel:
+		return "snake"
+	default:
+		return ""
+	}
+}
+
+func (c *jsonTagNamingChecker) tagName(tag reflect.StructTag, key string) string {
+	name := strings.Split(tag.Get(key), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// matches reports whether name follows the naming convention.
+// Single lower-case words match both conventions.
+func (c *jsonTagNamingChecker) matches(name, naming string) bool {
+	if name == "" {
+		return false
+	}
+	switch naming {
+	case "camel":
+		return unicode.IsLower(rune(name[0])) && !strings.ContainsAny(name, "_-")
+	case "snake":
+		return strings.ToLower(name) == name && !strings.Contains(name, "-")
+	default:
+		return true
+	}
+}
+
+func (c *jsonTagNamingChecker) warn(field *ast.Field, key, name, naming string) {
+	convention := "camelCase"
+	if naming == "snake" {
+		convention = "snake_case"
+	}
+	c.ctx.Warn(field.Tag, "%s tag name %q doesn't follow %s naming", key, name, convention)
+}
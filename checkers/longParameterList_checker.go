@@ -0,0 +1,60 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "longParameterList"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"maxParams": {
+			Value: 6,
+			Usage: "max number of function parameters",
+		},
+		"exportedOnly": {
+			Value: false,
+			Usage: "whether to check only exported functions and methods",
+		},
+	}
+	info.Summary = "Detects functions with too many parameters"
+	info.Details = "Parameters declared in a single group, like `a, b int`, are counted individually."
+	info.Before = `func NewServer(host string, port int, tls bool, timeout, idle time.Duration, logger *log.Logger, maxConns int)`
+	info.After = `func NewServer(cfg ServerConfig)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&longParameterListChecker{
+			ctx:          ctx,
+			maxParams:    info.Params.Int("maxParams"),
+			exportedOnly: info.Params.Bool("exportedOnly"),
+		})
+	})
+}
+
+type longParameterListChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	maxParams    int
+	exportedOnly bool
+}
+
+func (c *longParameterListChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	return !c.exportedOnly || fn.Name.IsExported()
+}
+
+func (c *longParameterListChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	n := decl.Type.Params.NumFields()
+	if n > c.maxParams {
+		c.warn(decl, n)
+	}
+}
+
+func (c *longParameterListChecker) warn(decl *ast.FuncDecl, n int) {
+	c.ctx.Warn(decl.Name, "%s has %d params, max is %d; consider grouping them into a struct",
+		decl.Name, n, c.maxParams)
+}
@@ -0,0 +1,199 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "loopVarCapture"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects loop variables captured by goroutines and deferred or stored closures"
+	info.Details = `Before Go 1.22, loop variables are shared between iterations,
+so closures that outlive the iteration observe the last value.
+Only reported when the target Go version is older than 1.22.`
+	info.Before = `
+for _, x := range xs {
+	go func() {
+		process(x)
+	}()
+}`
+	info.After = `
+for _, x := range xs {
+	x := x
+	go func() {
+		process(x)
+	}()
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForStmt(&loopVarCaptureChecker{ctx: ctx})
+	})
+}
+
+type loopVarCaptureChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *loopVarCaptureChecker) EnterFile(f *ast.File) bool {
+	return !c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 22})
+}
+
+func (c *loopVarCaptureChecker) VisitStmt(stmt ast.Stmt) {
+	var vars []types.Object
+	var body *ast.BlockStmt
+	switch stmt := stmt.(type) {
+	case *ast.RangeStmt:
+		if stmt.Tok != token.DEFINE {
+			return
+		}
+		vars = c.objectsOf(stmt.Key, stmt.Value)
+		body = stmt.Body
+	case *ast.ForStmt:
+		init := astcast.ToAssignStmt(stmt.Init)
+		if init.Tok != token.DEFINE {
+			return
+		}
+		vars = c.objectsOf(init.Lhs...)
+		body = stmt.Body
+	default:
+		return
+	}
+	if len(vars) == 0 {
+		return
+	}
+
+	// Closures assigned to the iteration-local variables
+	// only escape if these variables do.
+	closures := make(map[types.Object]*ast.FuncLit)
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, rhs := range assign.Rhs {
+			lit, ok := rhs.(*ast.FuncLit)
+			if !ok {
+				continue
+			}
+			if obj := c.localObj(assign.Lhs[i], body); obj != nil {
+				closures[obj] = lit
+			}
+		}
+		return true
+	})
+
+	checked := make(map[*ast.FuncLit]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		for _, x := range c.escapingExprs(n, body) {
+			var lit *ast.FuncLit
+			switch x := x.(type) {
+			case *ast.FuncLit:
+				lit = x
+			case *ast.Ident:
+				lit = closures[c.ctx.TypesInfo.Uses[x]]
+			}
+			if lit != nil && !checked[lit] {
+				checked[lit] = true
+				c.checkFuncLit(lit, vars)
+			}
+		}
+		return true
+	})
+}
+
+// escapingExprs returns n expressions whose values may be used
+// after the current loop iteration is finished.
+func (c *loopVarCaptureChecker) escapingExprs(n ast.Node, body *ast.BlockStmt) []ast.Expr {
+	var candidates []ast.Expr
+	switch n := n.(type) {
+	case *ast.GoStmt:
+		candidates = append(candidates, n.Call.Fun)
+		candidates = append(candidates, n.Call.Args...)
+	case *ast.DeferStmt:
+		candidates = append(candidates, n.Call.Fun)
+	case *ast.AssignStmt:
+		if len(n.Lhs) != len(n.Rhs) {
+			break
+		}
+		for i, rhs := range n.Rhs {
+			if c.localObj(n.Lhs[i], body) == nil {
+				candidates = append(candidates, rhs)
+			}
+		}
+	case *ast.SendStmt:
+		candidates = append(candidates, n.Value)
+	case *ast.ReturnStmt:
+		candidates = append(candidates, n.Results...)
+	case *ast.CallExpr:
+		switch fn := n.Fun.(type) {
+		case *ast.Ident:
+			if fn.Name == "append" {
+				candidates = append(candidates, n.Args...)
+			}
+		case *ast.SelectorExpr:
+			// errgroup.Group.Go and similar goroutine launchers.
+			if fn.Sel.Name == "Go" {
+				candidates = append(candidates, n.Args...)
+			}
+		}
+	}
+	return candidates
+}
+
+// localObj returns a variable object if x is an identifier
+// declared inside the loop body, nil otherwise.
+func (c *loopVarCaptureChecker) localObj(x ast.Expr, body *ast.BlockStmt) types.Object {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil || obj.Pos() < body.Pos() || obj.Pos() >= body.End() {
+		return nil
+	}
+	return obj
+}
+
+func (c *loopVarCaptureChecker) checkFuncLit(lit *ast.FuncLit, vars []types.Object) {
+	reported := make(map[types.Object]bool)
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := c.ctx.TypesInfo.Uses[id]
+		for _, v := range vars {
+			if obj == v && !reported[v] {
+				reported[v] = true
+				c.warn(id)
+			}
+		}
+		return true
+	})
+}
+
+func (c *loopVarCaptureChecker) objectsOf(list ...ast.Expr) []types.Object {
+	var objects []types.Object
+	for _, x := range list {
+		id, ok := x.(*ast.Ident)
+		if !ok || id.Name == "_" {
+			continue
+		}
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			objects = append(objects, obj)
+		}
+	}
+	return objects
+}
+
+func (c *loopVarCaptureChecker) warn(id *ast.Ident) {
+	c.ctx.Warn(id, "loop variable %s captured by func literal is shared between iterations before Go 1.22", id)
+}
@@ -0,0 +1,169 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "mapsPkgSuggest"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects map loops that can use the maps package or clear builtin"
+	info.Details = `Suggests maps.Copy, maps.Clone and clear for Go 1.21 and later;
+maps.Keys (with slices.AppendSeq) is suggested for Go 1.23 and later.`
+	info.Before = `
+for k := range m {
+	delete(m, k)
+}`
+	info.After = `clear(m)`
+	info.Note = "Unlike make, maps.Clone returns nil for a nil map."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForStmtList(&mapsPkgSuggestChecker{ctx: ctx})
+	})
+}
+
+type mapsPkgSuggestChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *mapsPkgSuggestChecker) EnterFile(f *ast.File) bool {
+	return c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21})
+}
+
+func (c *mapsPkgSuggestChecker) VisitStmtList(list []ast.Stmt) {
+	for i, stmt := range list {
+		loop, ok := stmt.(*ast.RangeStmt)
+		if !ok || !c.isMap(loop.X) || len(loop.Body.List) != 1 || !c.isVar(loop.Key) {
+			continue
+		}
+		if !typep.SideEffectFree(c.ctx.TypesInfo, loop.X) {
+			continue
+		}
+		var prev ast.Stmt
+		if i > 0 {
+			prev = list[i-1]
+		}
+		switch body := loop.Body.List[0].(type) {
+		case *ast.ExprStmt:
+			c.checkClear(loop, body)
+		case *ast.AssignStmt:
+			if c.isVar(loop.Value) {
+				c.checkCopy(loop, body, prev)
+			} else {
+				c.checkKeys(loop, body)
+			}
+		}
+	}
+}
+
+// checkClear handles `for k := range m { delete(m, k) }`.
+func (c *mapsPkgSuggestChecker) checkClear(loop *ast.RangeStmt, body *ast.ExprStmt) {
+	if loop.Value != nil && !c.isBlank(loop.Value) {
+		return
+	}
+	call := astcast.ToCallExpr(body.X)
+	if qualifiedName(call.Fun) != "delete" || len(call.Args) != 2 {
+		return
+	}
+	if !astequal.Expr(call.Args[0], loop.X) || !c.sameVar(call.Args[1], loop.Key) {
+		return
+	}
+	c.warn(loop, "clear(%s)", loop.X)
+}
+
+// checkCopy handles `for k, v := range src { dst[k] = v }`.
+// If dst was created with make right before the loop, maps.Clone is suggested.
+func (c *mapsPkgSuggestChecker) checkCopy(loop *ast.RangeStmt, body *ast.AssignStmt, prev ast.Stmt) {
+	if body.Tok != token.ASSIGN || len(body.Lhs) != 1 || len(body.Rhs) != 1 {
+		return
+	}
+	index, ok := body.Lhs[0].(*ast.IndexExpr)
+	if !ok || !c.sameVar(index.Index, loop.Key) || !c.sameVar(body.Rhs[0], loop.Value) {
+		return
+	}
+	dst := index.X
+	if astequal.Expr(dst, loop.X) || !typep.SideEffectFree(c.ctx.TypesInfo, dst) {
+		return
+	}
+	if !types.Identical(c.ctx.TypeOf(dst), c.ctx.TypeOf(loop.X)) {
+		return
+	}
+	if c.isMakeOf(prev, dst) {
+		c.warn(loop, "%s = maps.Clone(%s)", dst, loop.X)
+		return
+	}
+	c.warn(loop, "maps.Copy(%s, %s)", dst, loop.X)
+}
+
+// checkKeys handles `for k := range m { keys = append(keys, k) }`.
+func (c *mapsPkgSuggestChecker) checkKeys(loop *ast.RangeStmt, body *ast.AssignStmt) {
+	if !c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 23}) {
+		return // maps.Keys returns an iterator since Go 1.23
+	}
+	if loop.Value != nil && !c.isBlank(loop.Value) {
+		return
+	}
+	if body.Tok != token.ASSIGN || len(body.Lhs) != 1 || len(body.Rhs) != 1 {
+		return
+	}
+	keys := body.Lhs[0]
+	call := astcast.ToCallExpr(body.Rhs[0])
+	if qualifiedName(call.Fun) != "append" || len(call.Args) != 2 || call.Ellipsis.IsValid() {
+		return
+	}
+	if !astequal.Expr(call.Args[0], keys) || !c.sameVar(call.Args[1], loop.Key) {
+		return
+	}
+	if !typep.SideEffectFree(c.ctx.TypesInfo, keys) {
+		return
+	}
+	c.warn(loop, "%s = slices.AppendSeq(%s, maps.Keys(%s))", keys, keys, loop.X)
+}
+
+// isMakeOf reports whether stmt is `dst := make(T[, n])`.
+func (c *mapsPkgSuggestChecker) isMakeOf(stmt ast.Stmt, dst ast.Expr) bool {
+	assign := astcast.ToAssignStmt(stmt)
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || !astequal.Expr(assign.Lhs[0], dst) {
+		return false
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	return qualifiedName(call.Fun) == "make"
+}
+
+func (c *mapsPkgSuggestChecker) isMap(x ast.Expr) bool {
+	_, ok := c.ctx.TypeOf(x).Underlying().(*types.Map)
+	return ok
+}
+
+// isVar reports whether x is a non-blank identifier.
+func (c *mapsPkgSuggestChecker) isVar(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && id.Name != "_"
+}
+
+func (c *mapsPkgSuggestChecker) isBlank(x ast.Expr) bool {
+	return astcast.ToIdent(x).Name == "_"
+}
+
+func (c *mapsPkgSuggestChecker) sameVar(x, v ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	return obj != nil && obj == c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(v))
+}
+
+func (c *mapsPkgSuggestChecker) warn(loop *ast.RangeStmt, suggestionFormat string, args ...interface{}) {
+	c.ctx.Warn(loop, "could replace the loop with "+suggestionFormat, args...)
+}
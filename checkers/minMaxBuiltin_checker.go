@@ -0,0 +1,175 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "minMaxBuiltin"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects hand-written min/max computations that can use min and max builtins"
+	info.Details = "Only reported for Go 1.21 and later, where min and max builtins are available."
+	info.Before = `
+if a < b {
+	return a
+}
+return b`
+	info.After = `return min(a, b)`
+	info.Note = "Floating-point operands are skipped as builtins handle NaN differently."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForStmtList(&minMaxBuiltinChecker{ctx: ctx})
+	})
+}
+
+type minMaxBuiltinChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *minMaxBuiltinChecker) EnterFile(f *ast.File) bool {
+	return c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21})
+}
+
+func (c *minMaxBuiltinChecker) VisitStmtList(list []ast.Stmt) {
+	for i, stmt := range list {
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Init != nil || len(ifStmt.Body.List) != 1 {
+			continue
+		}
+		if ifStmt.Else != nil {
+			c.checkAssign(ifStmt)
+		} else if i+1 < len(list) {
+			c.checkReturn(ifStmt, list[i+1])
+		}
+	}
+}
+
+// checkReturn handles `if a < b { return a }; return b` pattern.
+func (c *minMaxBuiltinChecker) checkReturn(ifStmt *ast.IfStmt, next ast.Stmt) {
+	ret1 := astcast.ToReturnStmt(ifStmt.Body.List[0])
+	ret2 := astcast.ToReturnStmt(next)
+	if len(ret1.Results) != 1 || len(ret2.Results) != 1 {
+		return
+	}
+	call := c.builtinCall(ifStmt, ret1.Results[0], ret2.Results[0])
+	if call == nil {
+		return
+	}
+	c.warn(ifStmt, next.End(), &ast.ReturnStmt{Results: []ast.Expr{call}})
+}
+
+// checkAssign handles `if a < b { x = a } else { x = b }` pattern.
+func (c *minMaxBuiltinChecker) checkAssign(ifStmt *ast.IfStmt) {
+	elseBody, ok := ifStmt.Else.(*ast.BlockStmt)
+	if !ok || len(elseBody.List) != 1 {
+		return
+	}
+	assign1 := astcast.ToAssignStmt(ifStmt.Body.List[0])
+	assign2 := astcast.ToAssignStmt(elseBody.List[0])
+	if assign1.Tok != token.ASSIGN || assign2.Tok != token.ASSIGN {
+		return
+	}
+	if len(assign1.Lhs) != 1 || len(assign2.Lhs) != 1 || len(assign1.Rhs) != 1 || len(assign2.Rhs) != 1 {
+		return
+	}
+	if !astequal.Expr(assign1.Lhs[0], assign2.Lhs[0]) {
+		return
+	}
+	call := c.builtinCall(ifStmt, assign1.Rhs[0], assign2.Rhs[0])
+	if call == nil {
+		return
+	}
+	c.warn(ifStmt, ifStmt.End(), &ast.AssignStmt{
+		Lhs: assign1.Lhs,
+		Tok: token.ASSIGN,
+		Rhs: []ast.Expr{call},
+	})
+}
+
+// builtinCall returns a min or max call that is equivalent to selecting
+// x if ifStmt condition is true and y otherwise.
+// Returns nil if there is no such equivalent.
+func (c *minMaxBuiltinChecker) builtinCall(ifStmt *ast.IfStmt, x, y ast.Expr) *ast.CallExpr {
+	cond := astcast.ToBinaryExpr(ifStmt.Cond)
+	var lessIsTrue bool
+	switch cond.Op {
+	case token.LSS, token.LEQ:
+		lessIsTrue = true
+	case token.GTR, token.GEQ:
+		lessIsTrue = false
+	default:
+		return nil
+	}
+
+	var pickFirst bool
+	switch {
+	case astequal.Expr(cond.X, x) && astequal.Expr(cond.Y, y):
+		pickFirst = true
+	case astequal.Expr(cond.X, y) && astequal.Expr(cond.Y, x):
+		pickFirst = false
+	default:
+		return nil
+	}
+
+	if !c.isSafeOperand(cond.X) || !c.isSafeOperand(cond.Y) {
+		return nil
+	}
+	if !types.Identical(c.ctx.TypeOf(cond.X), c.ctx.TypeOf(cond.Y)) {
+		return nil
+	}
+
+	name := "max"
+	if lessIsTrue == pickFirst {
+		name = "min"
+	}
+	if !c.isBuiltin(ifStmt.Pos(), name) {
+		return nil
+	}
+	return &ast.CallExpr{
+		Fun:  &ast.Ident{Name: name},
+		Args: []ast.Expr{cond.X, cond.Y},
+	}
+}
+
+func (c *minMaxBuiltinChecker) isSafeOperand(x ast.Expr) bool {
+	typ, ok := c.ctx.TypeOf(x).Underlying().(*types.Basic)
+	if !ok || typ.Info()&types.IsOrdered == 0 || typ.Info()&types.IsFloat != 0 {
+		return false
+	}
+	return typep.SideEffectFree(c.ctx.TypesInfo, x)
+}
+
+// isBuiltin reports whether name refers to a universe scope object at pos.
+func (c *minMaxBuiltinChecker) isBuiltin(pos token.Pos, name string) bool {
+	obj := types.Universe.Lookup(name)
+	if obj == nil {
+		return false // Older Go toolchain
+	}
+	scope := c.ctx.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return false
+	}
+	_, found := scope.LookupParent(name, pos)
+	return found == obj
+}
+
+func (c *minMaxBuiltinChecker) warn(ifStmt *ast.IfStmt, end token.Pos, suggestion ast.Stmt) {
+	s := astfmt.Sprint(suggestion)
+	fix := linter.QuickFix{
+		From:        ifStmt.Pos(),
+		To:          end,
+		Replacement: []byte(s),
+	}
+	c.ctx.WarnFixable(ifStmt, fix, "could replace with %s", s)
+}
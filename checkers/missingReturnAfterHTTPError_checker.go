@@ -0,0 +1,140 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "missingReturnAfterHTTPError"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects http.Error and error status WriteHeader calls that are not followed by return"
+	info.Before = `
+if err != nil {
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+w.Write(data)`
+	info.After = `
+if err != nil {
+	http.Error(w, err.Error(), http.StatusBadRequest)
+	return
+}
+w.Write(data)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&missingReturnAfterHTTPErrorChecker{ctx: ctx})
+	})
+}
+
+type missingReturnAfterHTTPErrorChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *missingReturnAfterHTTPErrorChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	c.walkList(decl.Body.List, false)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.FuncLit); ok {
+			c.walkList(lit.Body.List, false)
+		}
+		return true
+	})
+}
+
+// walkList checks the statements list.
+// If hasFollowing is true, execution continues after the list ends.
+func (c *missingReturnAfterHTTPErrorChecker) walkList(list []ast.Stmt, hasFollowing bool) {
+	for i, stmt := range list {
+		following := hasFollowing || i < len(list)-1
+		switch stmt := stmt.(type) {
+		case *ast.ExprStmt:
+			call := astcast.ToCallExpr(stmt.X)
+			if !c.isErrorResponse(call) {
+				continue
+			}
+			if following && !c.terminates(list[i+1:]) {
+				c.warn(call)
+			}
+		case *ast.BlockStmt:
+			c.walkList(stmt.List, following)
+		case *ast.IfStmt:
+			c.walkList(stmt.Body.List, following)
+			switch els := stmt.Else.(type) {
+			case *ast.BlockStmt:
+				c.walkList(els.List, following)
+			case *ast.IfStmt:
+				c.walkList([]ast.Stmt{els}, following)
+			}
+		case *ast.SwitchStmt:
+			c.walkClauses(stmt.Body, following)
+		case *ast.TypeSwitchStmt:
+			c.walkClauses(stmt.Body, following)
+		case *ast.ForStmt:
+			// Loop body is followed by the next iteration.
+			c.walkList(stmt.Body.List, true)
+		case *ast.RangeStmt:
+			c.walkList(stmt.Body.List, true)
+		}
+	}
+}
+
+func (c *missingReturnAfterHTTPErrorChecker) walkClauses(body *ast.BlockStmt, hasFollowing bool) {
+	for _, stmt := range body.List {
+		c.walkList(stmt.(*ast.CaseClause).Body, hasFollowing)
+	}
+}
+
+// terminates reports whether list contains a top-level statement
+// that transfers the control.
+func (c *missingReturnAfterHTTPErrorChecker) terminates(list []ast.Stmt) bool {
+	for _, stmt := range list {
+		switch stmt := stmt.(type) {
+		case *ast.ReturnStmt, *ast.BranchStmt:
+			return true
+		case *ast.ExprStmt:
+			if qualifiedName(astcast.ToCallExpr(stmt.X).Fun) == "panic" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isErrorResponse reports whether call is http.Error or
+// http.ResponseWriter.WriteHeader with error status code.
+func (c *missingReturnAfterHTTPErrorChecker) isErrorResponse(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return false
+	}
+	switch fn.FullName() {
+	case "net/http.Error":
+		return true
+	case "(net/http.ResponseWriter).WriteHeader":
+		if len(call.Args) != 1 {
+			return false
+		}
+		tv := c.ctx.TypesInfo.Types[call.Args[0]]
+		if tv.Value == nil {
+			return false
+		}
+		code, ok := constant.Int64Val(tv.Value)
+		return ok && code >= 400
+	default:
+		return false
+	}
+}
+
+func (c *missingReturnAfterHTTPErrorChecker) warn(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s is not followed by return; the handler keeps writing the response", cause.Fun)
+}
@@ -0,0 +1,171 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "namedReturnShadow"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects local variables that shadow named results relied upon by bare returns"
+	info.Details = `Assignments to a shadowing variable don't change the named result,
+so a bare return that follows the shadowing variable scope observes the zero value instead.
+Shadows that are returned explicitly, like in if err := f(); err != nil { return err },
+are not reported.`
+	info.Before = `
+func load() (err error) {
+	if cond {
+		err := save()
+		log(err)
+	}
+	return
+}`
+	info.After = `
+func load() (err error) {
+	if cond {
+		err = save()
+		log(err)
+	}
+	return
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&namedReturnShadowChecker{ctx: ctx})
+	})
+}
+
+type namedReturnShadowChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *namedReturnShadowChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || decl.Type.Results == nil {
+		return
+	}
+	results := make(map[string]types.Object)
+	for _, field := range decl.Type.Results.List {
+		for _, id := range field.Names {
+			if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil && id.Name != "_" {
+				results[id.Name] = obj
+			}
+		}
+	}
+	if len(results) == 0 {
+		return
+	}
+
+	var shadows []*ast.Ident
+	var bareReturns []*ast.ReturnStmt
+	assigned := make(map[types.Object]bool)
+	returned := make(map[types.Object]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			res, ok := results[n.Name]
+			if !ok {
+				break
+			}
+			if obj := c.ctx.TypesInfo.Defs[n]; obj != nil && obj != res {
+				shadows = append(shadows, n)
+			}
+		case *ast.ReturnStmt:
+			if len(n.Results) == 0 && !c.insideFuncLit(decl.Body, n) {
+				bareReturns = append(bareReturns, n)
+			}
+			for _, x := range n.Results {
+				if id, ok := x.(*ast.Ident); ok {
+					returned[c.ctx.TypesInfo.Uses[id]] = true
+				}
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				c.markAssigned(assigned, lhs)
+			}
+		case *ast.IncDecStmt:
+			c.markAssigned(assigned, n.X)
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				c.markAssigned(assigned, n.X)
+			}
+		}
+		return true
+	})
+	if len(shadows) == 0 || len(bareReturns) == 0 {
+		return
+	}
+
+	// shadowEnd maps a named result to the earliest end
+	// of the reported shadowing variables scopes.
+	shadowEnd := make(map[types.Object]token.Pos)
+	for _, id := range shadows {
+		obj := c.ctx.TypesInfo.Defs[id]
+		if returned[obj] || obj.Parent() == nil {
+			continue
+		}
+		end := obj.Parent().End()
+		if !c.followedByBareReturn(end, bareReturns) {
+			continue
+		}
+		res := results[id.Name]
+		if prev, ok := shadowEnd[res]; !ok || end < prev {
+			shadowEnd[res] = end
+		}
+		c.warnShadow(id)
+	}
+	for _, ret := range bareReturns {
+		for _, field := range decl.Type.Results.List {
+			for _, id := range field.Names {
+				obj := results[id.Name]
+				end, ok := shadowEnd[obj]
+				if ok && end <= ret.Pos() && !assigned[obj] {
+					c.warnZeroReturn(ret, id)
+				}
+			}
+		}
+	}
+}
+
+// followedByBareReturn reports whether any of the bareReturns comes after the pos.
+func (c *namedReturnShadowChecker) followedByBareReturn(pos token.Pos, bareReturns []*ast.ReturnStmt) bool {
+	for _, ret := range bareReturns {
+		if ret.Pos() >= pos {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *namedReturnShadowChecker) markAssigned(assigned map[types.Object]bool, x ast.Expr) {
+	if id, ok := x.(*ast.Ident); ok {
+		if obj := c.ctx.TypesInfo.Uses[id]; obj != nil {
+			assigned[obj] = true
+		}
+	}
+}
+
+func (c *namedReturnShadowChecker) insideFuncLit(body *ast.BlockStmt, n ast.Node) bool {
+	inside := false
+	ast.Inspect(body, func(x ast.Node) bool {
+		lit, ok := x.(*ast.FuncLit)
+		if ok && lit.Pos() <= n.Pos() && n.End() <= lit.End() {
+			inside = true
+		}
+		return !inside
+	})
+	return inside
+}
+
+func (c *namedReturnShadowChecker) warnShadow(id *ast.Ident) {
+	c.ctx.Warn(id, "%s shadows the named result; assignments to it are not returned", id)
+}
+
+func (c *namedReturnShadowChecker) warnZeroReturn(ret *ast.ReturnStmt, result *ast.Ident) {
+	c.ctx.Warn(ret, "bare return always returns zero value of %s, which is only assigned via a shadowing variable", result)
+}
@@ -2,9 +2,12 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
 )
 
 func init() {
@@ -18,6 +21,9 @@ func init() {
 		},
 	}
 	info.Summary = "Finds where nesting level could be reduced"
+	info.Details = `Reports if statements with a big body that are the only statement
+of a loop body, and if-else statements with a big body and a short else
+branch that returns or otherwise leaves the current block.`
 	info.Before = `
 for _, v := range a {
 	if v.Bool {
@@ -31,9 +37,14 @@ for _, v := range a {
 	}
 	body()
 }`
+	info.Note = `Quick fix is not suggested when the if statement has an init
+statement or its body declares names that are already in scope.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
-		c := &nestingReduceChecker{ctx: ctx}
+		c := &nestingReduceChecker{
+			ctx:     ctx,
+			elseIfs: make(map[*ast.IfStmt]bool),
+		}
 		c.bodyWidth = info.Params.Int("bodyWidth")
 		return astwalk.WalkerForStmt(c)
 	})
@@ -44,6 +55,10 @@ type nestingReduceChecker struct {
 	ctx *linter.CheckerContext
 
 	bodyWidth int
+
+	// elseIfs contains if statements that are else branches
+	// of other if statements. They can't be followed by the old body.
+	elseIfs map[*ast.IfStmt]bool
 }
 
 func (c *nestingReduceChecker) VisitStmt(stmt ast.Stmt) {
@@ -52,6 +67,15 @@ func (c *nestingReduceChecker) VisitStmt(stmt ast.Stmt) {
 		c.checkLoopBody(stmt.Body.List)
 	case *ast.RangeStmt:
 		c.checkLoopBody(stmt.Body.List)
+	case *ast.IfStmt:
+		if elseIf, ok := stmt.Else.(*ast.IfStmt); ok {
+			c.elseIfs[elseIf] = true
+		}
+		if c.elseIfs[stmt] {
+			delete(c.elseIfs, stmt)
+			return
+		}
+		c.checkIfElse(stmt)
 	}
 }
 
@@ -64,10 +88,156 @@ func (c *nestingReduceChecker) checkLoopBody(body []ast.Stmt) {
 		return
 	}
 	if len(stmt.Body.List) >= c.bodyWidth && stmt.Else == nil {
-		c.warnLoop(stmt)
+		indent := c.indentOf(stmt)
+		fix, ok := c.suggestInverted(stmt, "\n"+indent+"\tcontinue\n"+indent)
+		if !ok {
+			c.warnLoop(stmt)
+			return
+		}
+		c.warnLoopFixable(stmt, fix)
+	}
+}
+
+func (c *nestingReduceChecker) checkIfElse(stmt *ast.IfStmt) {
+	els, ok := stmt.Else.(*ast.BlockStmt)
+	if !ok || len(stmt.Body.List) < c.bodyWidth {
+		return
+	}
+	if len(els.List) == 0 || len(els.List) >= c.bodyWidth || !c.isTerminating(els.List[len(els.List)-1]) {
+		return
+	}
+	fix, ok := c.suggestInverted(stmt, c.text(els.Lbrace+1, els.Rbrace))
+	if !ok {
+		c.warnIfElse(stmt)
+		return
 	}
+	c.warnIfElseFixable(stmt, fix)
+}
+
+// isTerminating reports whether stmt makes the control flow
+// leave the current block.
+func (c *nestingReduceChecker) isTerminating(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		return ok && qualifiedName(call.Fun) == "panic"
+	default:
+		return false
+	}
+}
+
+// suggestInverted returns a fix that inverts the stmt condition,
+// uses newBody as its body and moves the old body after the statement.
+func (c *nestingReduceChecker) suggestInverted(stmt *ast.IfStmt, newBody string) (linter.QuickFix, bool) {
+	if stmt.Init != nil || c.declaresNamesInScope(stmt) {
+		return linter.QuickFix{}, false
+	}
+	if c.ctx.SourceText(stmt.Pos(), stmt.End()) == nil {
+		return linter.QuickFix{}, false
+	}
+	body := c.text(stmt.Body.Lbrace+1, stmt.Body.Rbrace)
+	if !strings.HasPrefix(body, "\n") {
+		return linter.QuickFix{}, false // Something on the same line with {
+	}
+	if c.hasMultilineLit(stmt.Body) {
+		return linter.QuickFix{}, false // Can't re-indent it safely
+	}
+
+	// Old body is moved one level up.
+	indent := c.indentOf(stmt)
+	lines := strings.Split(strings.TrimRight(body[len("\n"):], " \t\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimPrefix(l, "\t")
+	}
+	lines[0] = strings.TrimPrefix(lines[0], indent)
+
+	var buf strings.Builder
+	buf.WriteString("if " + astfmt.Sprint(c.negate(stmt.Cond)) + " {")
+	buf.WriteString(newBody)
+	buf.WriteString("}\n" + indent)
+	buf.WriteString(strings.Join(lines, "\n"))
+
+	fix := linter.QuickFix{
+		From:        stmt.Pos(),
+		To:          stmt.End(),
+		Replacement: []byte(buf.String()),
+	}
+	return fix, true
+}
+
+// declaresNamesInScope reports whether stmt body declares names that
+// would conflict with or shadow the visible names after the old body
+// is moved to the enclosing block.
+func (c *nestingReduceChecker) declaresNamesInScope(stmt *ast.IfStmt) bool {
+	ifScope := c.ctx.TypesInfo.Scopes[stmt]
+	bodyScope := c.ctx.TypesInfo.Scopes[stmt.Body]
+	if ifScope == nil || bodyScope == nil {
+		return true
+	}
+	for _, name := range bodyScope.Names() {
+		if _, obj := ifScope.Parent().LookupParent(name, token.NoPos); obj != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *nestingReduceChecker) hasMultilineLit(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && strings.Contains(lit.Value, "\n") {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func (c *nestingReduceChecker) negate(cond ast.Expr) ast.Expr {
+	switch cond := cond.(type) {
+	case *ast.UnaryExpr:
+		if cond.Op == token.NOT {
+			return cond.X
+		}
+	case *ast.BinaryExpr:
+		// Other comparisons are not inverted because of NaN.
+		switch cond.Op {
+		case token.EQL:
+			return &ast.BinaryExpr{X: cond.X, Op: token.NEQ, Y: cond.Y}
+		case token.NEQ:
+			return &ast.BinaryExpr{X: cond.X, Op: token.EQL, Y: cond.Y}
+		}
+		return &ast.UnaryExpr{Op: token.NOT, X: &ast.ParenExpr{X: cond}}
+	case *ast.ParenExpr:
+		return c.negate(cond.X)
+	}
+	return &ast.UnaryExpr{Op: token.NOT, X: cond}
+}
+
+func (c *nestingReduceChecker) indentOf(n ast.Node) string {
+	return strings.Repeat("\t", c.ctx.FileSet.Position(n.Pos()).Column-1)
+}
+
+// text returns the source text in [from, to) range.
+// Returns empty string if the source is not available.
+func (c *nestingReduceChecker) text(from, to token.Pos) string {
+	return string(c.ctx.SourceText(from, to))
 }
 
 func (c *nestingReduceChecker) warnLoop(cause ast.Node) {
 	c.ctx.Warn(cause, "invert if cond, replace body with `continue`, move old body after the statement")
 }
+
+func (c *nestingReduceChecker) warnLoopFixable(cause ast.Node, fix linter.QuickFix) {
+	c.ctx.WarnFixable(cause, fix, "invert if cond, replace body with `continue`, move old body after the statement")
+}
+
+func (c *nestingReduceChecker) warnIfElse(cause ast.Node) {
+	c.ctx.Warn(cause, "invert if cond, replace body with the else branch, move old body after the statement")
+}
+
+func (c *nestingReduceChecker) warnIfElseFixable(cause ast.Node, fix linter.QuickFix) {
+	c.ctx.WarnFixable(cause, fix, "invert if cond, replace body with the else branch, move old body after the statement")
+}
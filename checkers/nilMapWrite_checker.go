@@ -0,0 +1,205 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/cfg"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "nilMapWrite"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects writes into local maps that can be nil on some path to the write"
+	info.Details = `A map declared with var and no initializer is nil, writing into it panics.
+The control flow graph is used to find paths from the declaration to the write
+that don't assign the map.`
+	info.Before = `
+var counts map[string]int
+for _, w := range words {
+	counts[w]++
+}`
+	info.After = `
+counts := make(map[string]int)
+for _, w := range words {
+	counts[w]++
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&nilMapWriteChecker{ctx: ctx})
+	})
+}
+
+type nilMapWriteChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *nilMapWriteChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	c.checkBody(decl.Body)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.FuncLit); ok {
+			c.checkBody(lit.Body)
+		}
+		return true
+	})
+}
+
+func (c *nilMapWriteChecker) checkBody(body *ast.BlockStmt) {
+	g := cfg.New(body, func(*ast.CallExpr) bool { return true })
+	for _, b := range g.Blocks {
+		for i, n := range b.Nodes {
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok || len(spec.Values) != 0 {
+				continue
+			}
+			for _, id := range spec.Names {
+				obj := c.ctx.TypesInfo.ObjectOf(id)
+				if obj == nil || !c.isMap(obj) || c.escapes(body, obj) {
+					continue
+				}
+				if write := c.findNilWrite(b, i+1, obj); write != nil {
+					c.warn(write, id)
+				}
+			}
+		}
+	}
+}
+
+// findNilWrite returns the first map write that is reachable
+// from b.Nodes[start] without assigning obj.
+func (c *nilMapWriteChecker) findNilWrite(b *cfg.Block, start int, obj types.Object) ast.Node {
+	visited := make(map[*cfg.Block]bool)
+	var walk func(b *cfg.Block, start int) ast.Node
+	walk = func(b *cfg.Block, start int) ast.Node {
+		for _, n := range b.Nodes[start:] {
+			if write := c.findWrite(n, obj); write != nil {
+				return write
+			}
+			if c.isAssigned(n, obj) {
+				return nil
+			}
+		}
+		nonNil := c.nonNilSucc(b, obj)
+		for _, succ := range b.Succs {
+			if visited[succ] || succ == nonNil {
+				continue
+			}
+			visited[succ] = true
+			if write := walk(succ, 0); write != nil {
+				return write
+			}
+		}
+		return nil
+	}
+	return walk(b, start)
+}
+
+// nonNilSucc returns a successor of b that is only reached
+// when obj is not nil, like the else branch of `if m == nil`.
+func (c *nilMapWriteChecker) nonNilSucc(b *cfg.Block, obj types.Object) *cfg.Block {
+	if len(b.Nodes) == 0 || len(b.Succs) != 2 {
+		return nil
+	}
+	cond, ok := b.Nodes[len(b.Nodes)-1].(*ast.BinaryExpr)
+	if !ok || !c.isObj(cond.X, obj) || !c.ctx.TypesInfo.Types[cond.Y].IsNil() {
+		return nil
+	}
+	switch cond.Op {
+	case token.EQL:
+		return b.Succs[1]
+	case token.NEQ:
+		return b.Succs[0]
+	default:
+		return nil
+	}
+}
+
+// findWrite finds `m[k] = v` or `m[k]++` statement inside n.
+func (c *nilMapWriteChecker) findWrite(n ast.Node, obj types.Object) ast.Node {
+	var write ast.Node
+	ast.Inspect(n, func(n ast.Node) bool {
+		if write != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if c.isIndexOf(lhs, obj) {
+					write = n
+				}
+			}
+		case *ast.IncDecStmt:
+			if c.isIndexOf(n.X, obj) {
+				write = n
+			}
+		}
+		return true
+	})
+	return write
+}
+
+// isAssigned reports whether n assigns a new value to obj.
+func (c *nilMapWriteChecker) isAssigned(n ast.Node, obj types.Object) bool {
+	switch n := n.(type) {
+	case *ast.Ident:
+		// Range key/value and select receive assignments.
+		return c.isObj(n, obj)
+	case *ast.AssignStmt:
+		for _, lhs := range n.Lhs {
+			if c.isObj(lhs, obj) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// escapes reports whether obj can be assigned in a way
+// that is not visible in the control flow graph of body.
+func (c *nilMapWriteChecker) escapes(body *ast.BlockStmt, obj types.Object) bool {
+	escapes := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			if n.Op == token.AND && c.isObj(n.X, obj) {
+				escapes = true
+			}
+		case *ast.FuncLit:
+			ast.Inspect(n.Body, func(n ast.Node) bool {
+				if c.isAssigned(n, obj) {
+					escapes = true
+				}
+				return !escapes
+			})
+			return false
+		}
+		return !escapes
+	})
+	return escapes
+}
+
+func (c *nilMapWriteChecker) isIndexOf(x ast.Expr, obj types.Object) bool {
+	index, ok := x.(*ast.IndexExpr)
+	return ok && c.isObj(index.X, obj)
+}
+
+func (c *nilMapWriteChecker) isObj(x ast.Expr, obj types.Object) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(id) == obj
+}
+
+func (c *nilMapWriteChecker) isMap(obj types.Object) bool {
+	_, ok := obj.Type().Underlying().(*types.Map)
+	return ok
+}
+
+func (c *nilMapWriteChecker) warn(cause ast.Node, id *ast.Ident) {
+	c.ctx.Warn(cause, "%s may be nil here, writing into a nil map panics; initialize it with make", id)
+}
@@ -0,0 +1,107 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "panicInLibrary"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"skipFuncPrefixes": {
+			Value: "Must",
+			Usage: "comma-separated list of function name prefixes that are allowed to panic",
+		},
+	}
+	info.Summary = "Detects panic calls in exported functions of non-main packages"
+	info.Details = `Library callers can't handle a panic as a regular failure,
+so exported functions should return an error instead.
+Must* helpers, test files and panics re-raised from deferred calls are ignored.`
+	info.Before = `
+func Parse(s string) *Config {
+	if s == "" {
+		panic("empty config")
+	}
+	...
+}`
+	info.After = `
+func Parse(s string) (*Config, error) {
+	if s == "" {
+		return nil, errors.New("empty config")
+	}
+	...
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &panicInLibraryChecker{ctx: ctx}
+		for _, prefix := range strings.Split(info.Params.String("skipFuncPrefixes"), ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				c.skipPrefixes = append(c.skipPrefixes, prefix)
+			}
+		}
+		return astwalk.WalkerForFuncDecl(c)
+	})
+}
+
+type panicInLibraryChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	skipPrefixes []string
+}
+
+func (c *panicInLibraryChecker) EnterFile(f *ast.File) bool {
+	return f.Name.Name != "main" && !strings.HasSuffix(c.ctx.Filename, "_test.go")
+}
+
+func (c *panicInLibraryChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	if fn.Body == nil || !fn.Name.IsExported() {
+		return false
+	}
+	if fn.Recv != nil {
+		recv := identOf(fn.Recv.List[0].Type)
+		if recv == nil || !recv.IsExported() {
+			return false
+		}
+	}
+	for _, prefix := range c.skipPrefixes {
+		if strings.HasPrefix(fn.Name.Name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *panicInLibraryChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.DeferStmt:
+			// Re-panic after recover is a common cleanup pattern.
+			return false
+		case *ast.CallExpr:
+			if c.isPanic(n) {
+				c.warn(n, decl.Name)
+			}
+		}
+		return true
+	})
+}
+
+func (c *panicInLibraryChecker) isPanic(call *ast.CallExpr) bool {
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "panic" {
+		return false
+	}
+	_, ok = c.ctx.TypesInfo.ObjectOf(fn).(*types.Builtin)
+	return ok
+}
+
+func (c *panicInLibraryChecker) warn(cause ast.Node, fn *ast.Ident) {
+	c.ctx.Warn(cause, "panic in exported function %s; return an error instead", fn)
+}
@@ -2,6 +2,7 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
@@ -15,6 +16,7 @@ func init() {
 	info.Summary = "Detects if function parameters could be combined by type and suggest the way to do it"
 	info.Before = `func foo(a, b int, c, d int, e, f int, g int) {}`
 	info.After = `func foo(a, b, c, d, e, f, g int) {}`
+	info.Note = `Quick fix removes redundant types and keeps parameter names and comments as is.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		return astwalk.WalkerForFuncDecl(&paramTypeCombineChecker{ctx: ctx})
@@ -33,7 +35,7 @@ func (c *paramTypeCombineChecker) EnterFunc(*ast.FuncDecl) bool {
 func (c *paramTypeCombineChecker) VisitFuncDecl(decl *ast.FuncDecl) {
 	typ := c.optimizeFuncType(decl.Type)
 	if !astequal.Expr(typ, decl.Type) {
-		c.warn(decl.Type, typ)
+		c.warn(decl.Type, typ, c.suggestFix(decl.Type))
 	}
 }
 
@@ -44,16 +46,7 @@ func (c *paramTypeCombineChecker) optimizeFuncType(f *ast.FuncType) *ast.FuncTyp
 	}
 }
 func (c *paramTypeCombineChecker) optimizeParams(params *ast.FieldList) *ast.FieldList {
-	// To avoid false positives, skip unnamed param lists.
-	//
-	// We're using a property that Go only permits unnamed params
-	// for the whole list, so it's enough to check whether any of
-	// ast.Field have empty name list.
-	skip := params == nil ||
-		len(params.List) < 2 ||
-		len(params.List[0].Names) == 0 ||
-		c.paramsAreMultiLine(params)
-	if skip {
+	if !c.canCombine(params) {
 		return params
 	}
 
@@ -82,8 +75,63 @@ func (c *paramTypeCombineChecker) optimizeParams(params *ast.FieldList) *ast.Fie
 	}
 }
 
-func (c *paramTypeCombineChecker) warn(f1, f2 *ast.FuncType) {
-	c.ctx.Warn(f1, "%s could be replaced with %s", f1, f2)
+func (c *paramTypeCombineChecker) canCombine(params *ast.FieldList) bool {
+	// To avoid false positives, skip unnamed param lists.
+	//
+	// We're using a property that Go only permits unnamed params
+	// for the whole list, so it's enough to check whether any of
+	// ast.Field have empty name list.
+	return params != nil &&
+		len(params.List) >= 2 &&
+		len(params.List[0].Names) != 0 &&
+		!c.paramsAreMultiLine(params)
+}
+
+// suggestFix returns a fix that removes the types of the params
+// that share their type with the next param.
+// Everything else is copied from the source, so comments are preserved.
+func (c *paramTypeCombineChecker) suggestFix(typ *ast.FuncType) linter.QuickFix {
+	from := typ.Params.Opening
+	to := typ.End()
+	src := c.ctx.SourceText(from, to)
+	if src == nil {
+		return linter.QuickFix{}
+	}
+	offset := func(pos token.Pos) int {
+		return int(pos - from)
+	}
+
+	var buf []byte
+	last := 0
+	for _, params := range []*ast.FieldList{typ.Params, typ.Results} {
+		if !c.canCombine(params) {
+			continue
+		}
+		for i, p := range params.List[1:] {
+			prev := params.List[i]
+			if !astequal.Expr(p.Type, prev.Type) {
+				continue
+			}
+			// Drop the type along with the whitespace before it.
+			typeStart := offset(prev.Type.Pos())
+			for typeStart > last && (src[typeStart-1] == ' ' || src[typeStart-1] == '\t') {
+				typeStart--
+			}
+			buf = append(buf, src[last:typeStart]...)
+			last = offset(prev.Type.End())
+		}
+	}
+	buf = append(buf, src[last:offset(to)]...)
+
+	return linter.QuickFix{
+		From:        from,
+		To:          to,
+		Replacement: buf,
+	}
+}
+
+func (c *paramTypeCombineChecker) warn(f1, f2 *ast.FuncType, fix linter.QuickFix) {
+	c.ctx.WarnFixable(f1, fix, "%s could be replaced with %s", f1, f2)
 }
 
 func (c *paramTypeCombineChecker) paramsAreMultiLine(params *ast.FieldList) bool {
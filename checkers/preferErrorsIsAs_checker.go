@@ -0,0 +1,148 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/astp"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "preferErrorsIsAs"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects error comparisons and type assertions that break on wrapped errors"
+	info.Before = `
+if err == io.EOF {}
+if e, ok := err.(*os.PathError); ok {}`
+	info.After = `
+if errors.Is(err, io.EOF) {}
+var e *os.PathError
+if errors.As(err, &e) {}`
+	info.Note = "Quick fixes are only suggested for comparisons in files that already import errors package."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForLocalExpr(&preferErrorsIsAsChecker{ctx: ctx})
+	})
+}
+
+type preferErrorsIsAsChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// errorsPkg is a name under which "errors" package
+	// is imported in the current file; empty if it's not imported.
+	errorsPkg string
+}
+
+func (c *preferErrorsIsAsChecker) EnterFile(f *ast.File) bool {
+	c.errorsPkg = importedPkgName(f, "errors")
+	return true
+}
+
+func (c *preferErrorsIsAsChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	// Is and As method implementations are expected to
+	// compare errors directly, they're the building blocks
+	// for the errors.Is and errors.As.
+	if fn.Recv != nil && (fn.Name.Name == "Is" || fn.Name.Name == "As") {
+		return false
+	}
+	return fn.Body != nil
+}
+
+func (c *preferErrorsIsAsChecker) VisitLocalExpr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op == token.EQL || expr.Op == token.NEQ {
+			c.checkComparison(expr)
+		}
+	case *ast.TypeAssertExpr:
+		c.checkTypeAssert(expr)
+	}
+}
+
+func (c *preferErrorsIsAsChecker) checkComparison(cmp *ast.BinaryExpr) {
+	var err, target ast.Expr
+	switch {
+	case c.isErrorValue(cmp.X) && c.isSentinelError(cmp.Y):
+		err, target = cmp.X, cmp.Y
+	case c.isErrorValue(cmp.Y) && c.isSentinelError(cmp.X):
+		err, target = cmp.Y, cmp.X
+	default:
+		return
+	}
+
+	pkg := c.errorsPkg
+	if pkg == "" {
+		pkg = "errors"
+	}
+	var suggestion ast.Expr = &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   &ast.Ident{Name: pkg},
+			Sel: &ast.Ident{Name: "Is"},
+		},
+		Args: []ast.Expr{err, target},
+	}
+	if cmp.Op == token.NEQ {
+		suggestion = &ast.UnaryExpr{Op: token.NOT, X: suggestion}
+	}
+
+	if c.errorsPkg == "" {
+		c.warnComparison(cmp, suggestion)
+		return
+	}
+	fix := linter.QuickFix{
+		From:        cmp.Pos(),
+		To:          cmp.End(),
+		Replacement: []byte(astfmt.Sprint(suggestion)),
+	}
+	c.ctx.WarnFixable(cmp, fix, "use %s instead of %s to handle wrapped errors",
+		suggestion, cmp)
+}
+
+func (c *preferErrorsIsAsChecker) checkTypeAssert(assert *ast.TypeAssertExpr) {
+	if assert.Type == nil {
+		return // Type switch
+	}
+	if !c.isErrorValue(assert.X) {
+		return
+	}
+	if _, ok := c.ctx.TypeOf(assert.Type).Underlying().(*types.Interface); ok {
+		return // Interface checks like err.(interface{ Timeout() bool })
+	}
+	c.warnTypeAssert(assert)
+}
+
+// isErrorValue reports whether x is a non-nil expression of error type.
+func (c *preferErrorsIsAsChecker) isErrorValue(x ast.Expr) bool {
+	if qualifiedName(x) == "nil" {
+		return false
+	}
+	return types.Identical(c.ctx.TypeOf(x), types.Universe.Lookup("error").Type())
+}
+
+// isSentinelError reports whether x is a package-level error variable.
+func (c *preferErrorsIsAsChecker) isSentinelError(x ast.Expr) bool {
+	x = astutil.Unparen(x)
+	if !astp.IsIdent(x) && !astp.IsSelectorExpr(x) {
+		return false
+	}
+	v, ok := c.ctx.TypesInfo.ObjectOf(identOf(x)).(*types.Var)
+	if !ok || v.Pkg() == nil || v.Parent() != v.Pkg().Scope() {
+		return false
+	}
+	return types.Implements(v.Type(), types.Universe.Lookup("error").Type().Underlying().(*types.Interface))
+}
+
+func (c *preferErrorsIsAsChecker) warnComparison(cause *ast.BinaryExpr, suggestion ast.Expr) {
+	c.ctx.Warn(cause, "use %s instead of %s to handle wrapped errors", suggestion, cause)
+}
+
+func (c *preferErrorsIsAsChecker) warnTypeAssert(cause *ast.TypeAssertExpr) {
+	c.ctx.Warn(cause, "use errors.As instead of %s to handle wrapped errors", cause)
+}
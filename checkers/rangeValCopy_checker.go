@@ -2,9 +2,15 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
@@ -14,7 +20,7 @@ func init() {
 	info.Params = linter.CheckerParams{
 		"sizeThreshold": {
 			Value: 128,
-			Usage: "size in bytes that makes the warning trigger",
+			Usage: "size in bytes that makes the warning trigger; computed for the target GOARCH",
 		},
 		"skipTestFuncs": {
 			Value: true,
@@ -22,7 +28,8 @@ func init() {
 		},
 	}
 	info.Summary = "Detects loops that copy big objects during each iteration"
-	info.Details = "Suggests to use index access or take address and make use pointer instead."
+	info.Details = `Suggests to use index access or take address and make use pointer instead.
+Quick fix takes the element address when the loop only reads the value fields.`
 	info.Before = `
 xs := make([][1024]byte, length)
 for _, x := range xs {
@@ -66,10 +73,171 @@ func (c *rangeValCopyChecker) VisitStmt(stmt ast.Stmt) {
 		return
 	}
 	if size := c.ctx.SizesInfo.Sizeof(typ); size >= c.sizeThreshold {
-		c.warn(rng, size)
+		if fix, ok := c.suggestFix(rng); ok {
+			c.warnFixable(rng, size, fix)
+		} else {
+			c.warn(rng, size)
+		}
+	}
+}
+
+// suggestFix returns a quick fix that rewrites the loop to take
+// element addresses. It's only suggested when the loop body uses
+// the value for reading fields and doesn't modify the ranged slice.
+func (c *rangeValCopyChecker) suggestFix(rng *ast.RangeStmt) (linter.QuickFix, bool) {
+	if rng.Tok != token.DEFINE {
+		return linter.QuickFix{}, false
+	}
+	val, ok := rng.Value.(*ast.Ident)
+	if !ok || val.Name == "_" {
+		return linter.QuickFix{}, false
+	}
+	switch typ := c.ctx.TypeOf(rng.X).Underlying().(type) {
+	case *types.Slice:
+	case *types.Array:
+	case *types.Pointer:
+		if _, ok := typ.Elem().Underlying().(*types.Array); !ok {
+			return linter.QuickFix{}, false
+		}
+	default:
+		return linter.QuickFix{}, false
+	}
+	if !typep.SideEffectFree(c.ctx.TypesInfo, rng.X) {
+		return linter.QuickFix{}, false
+	}
+	valObj := c.ctx.TypesInfo.ObjectOf(val)
+	if !c.isReadOnlyValue(rng.Body, valObj) || c.isModified(rng.Body, rng.X) {
+		return linter.QuickFix{}, false
+	}
+
+	key := "_"
+	if id, ok := rng.Key.(*ast.Ident); ok {
+		key = id.Name
+	}
+	if key == "_" {
+		key = c.freeIndexName(rng.Body.Pos())
+		if key == "" {
+			return linter.QuickFix{}, false
+		}
+	}
+
+	indent := strings.Repeat("\t", c.ctx.FileSet.Position(rng.For).Column)
+	replacement := astfmt.Sprintf("%s := range %s {\n%s%s := &%s[%s]",
+		key, rng.X, indent, val, rng.X, key)
+	fix := linter.QuickFix{
+		From:        rng.Key.Pos(),
+		To:          rng.Body.Lbrace + 1,
+		Replacement: []byte(replacement),
+	}
+	return fix, true
+}
+
+// freeIndexName returns an index variable name that
+// is not visible at pos or empty string if all are taken.
+func (c *rangeValCopyChecker) freeIndexName(pos token.Pos) string {
+	scope := c.ctx.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return ""
+	}
+	for _, name := range []string{"i", "j", "k", "idx"} {
+		if _, obj := scope.LookupParent(name, pos); obj == nil {
+			return name
+		}
+	}
+	return ""
+}
+
+// isReadOnlyValue reports whether all obj usages inside body
+// are field reads or value receiver method calls, so obj
+// can be replaced with a pointer.
+// See isReadOnlyCopyUse for the selector chains that are rejected.
+func (c *rangeValCopyChecker) isReadOnlyValue(body *ast.BlockStmt, obj types.Object) bool {
+	ok := true
+	var parents []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			parents = parents[:len(parents)-1]
+			return true
+		}
+		if id, isIdent := n.(*ast.Ident); isIdent && c.ctx.TypesInfo.ObjectOf(id) == obj {
+			if !isReadOnlyCopyUse(c.ctx, parents, id) {
+				ok = false
+			}
+		}
+		parents = append(parents, n)
+		return ok
+	})
+	return ok && !c.isModifiedObj(body, obj)
+}
+
+// isModified reports whether x is assigned, incremented or
+// has its address taken inside body; x can also be the root of
+// the modified expression, like in `x[i].f = v`.
+func (c *rangeValCopyChecker) isModified(body *ast.BlockStmt, x ast.Expr) bool {
+	id := identOf(x)
+	if id == nil {
+		return false
+	}
+	return c.isModifiedObj(body, c.ctx.TypesInfo.ObjectOf(id))
+}
+
+func (c *rangeValCopyChecker) isModifiedObj(body *ast.BlockStmt, obj types.Object) bool {
+	if obj == nil {
+		return false
+	}
+	isObj := func(x ast.Expr) bool {
+		id := c.rootIdent(x)
+		return id != nil && c.ctx.TypesInfo.ObjectOf(id) == obj
+	}
+	modified := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if isObj(lhs) {
+					modified = true
+				}
+			}
+		case *ast.IncDecStmt:
+			modified = modified || isObj(n.X)
+		case *ast.UnaryExpr:
+			modified = modified || (n.Op == token.AND && isObj(n.X))
+		case *ast.CallExpr:
+			// append(xs, ...) result may share the xs backing array.
+			if qualifiedName(n.Fun) == "append" && len(n.Args) != 0 && isObj(n.Args[0]) {
+				modified = true
+			}
+		}
+		return !modified
+	})
+	return modified
+}
+
+// rootIdent returns the identifier x selector and index
+// expressions chain starts with.
+func (c *rangeValCopyChecker) rootIdent(x ast.Expr) *ast.Ident {
+	for {
+		switch e := astutil.Unparen(x).(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			x = e.X
+		case *ast.IndexExpr:
+			x = e.X
+		case *ast.StarExpr:
+			x = e.X
+		case *ast.SliceExpr:
+			x = e.X
+		default:
+			return nil
+		}
 	}
 }
 
 func (c *rangeValCopyChecker) warn(n ast.Node, size int64) {
 	c.ctx.Warn(n, "each iteration copies %d bytes (consider pointers or indexing)", size)
 }
+
+func (c *rangeValCopyChecker) warnFixable(n ast.Node, size int64, fix linter.QuickFix) {
+	c.ctx.WarnFixable(n, fix, "each iteration copies %d bytes (consider pointers or indexing)", size)
+}
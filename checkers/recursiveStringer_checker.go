@@ -0,0 +1,224 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "recursiveStringer"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects String and Error methods that format their receiver, causing infinite recursion"
+	info.Details = `Formatting a value with %v, %s, %q or %x calls its String method.
+Calls through helper functions declared in the same file are followed one level deep.`
+	info.Before = `
+func (id userID) String() string {
+	return fmt.Sprintf("user-%s", id)
+}`
+	info.After = `
+func (id userID) String() string {
+	return fmt.Sprintf("user-%s", string(id))
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&recursiveStringerChecker{ctx: ctx})
+	})
+}
+
+type recursiveStringerChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// funcs maps functions declared in the current file to their declarations.
+	funcs map[*types.Func]*ast.FuncDecl
+}
+
+func (c *recursiveStringerChecker) EnterFile(f *ast.File) bool {
+	c.funcs = make(map[*types.Func]*ast.FuncDecl)
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Body == nil {
+			continue
+		}
+		if fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func); ok {
+			c.funcs[fn] = decl
+		}
+	}
+	return true
+}
+
+func (c *recursiveStringerChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	if fn.Body == nil || fn.Recv == nil || len(fn.Recv.List[0].Names) == 0 {
+		return false
+	}
+	switch fn.Name.Name {
+	case "String", "Error":
+		return fn.Type.Params.NumFields() == 0
+	default:
+		return false
+	}
+}
+
+func (c *recursiveStringerChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	recv := c.ctx.TypesInfo.ObjectOf(decl.Recv.List[0].Names[0])
+	if recv == nil {
+		return
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if arg := c.formattedArg(call, recv); arg != nil && c.hasMethod(arg, decl.Name.Name) {
+			c.warn(arg, decl, nil)
+			return true
+		}
+		// Follow helper functions declared in the same file.
+		for _, arg := range c.receiverArgs(call, recv) {
+			helper, param := c.helperParam(call, arg)
+			if helper == nil || !c.hasMethod(arg, decl.Name.Name) {
+				continue
+			}
+			if c.formatsParam(helper, param) {
+				c.warn(arg, decl, helper)
+			}
+		}
+		return true
+	})
+}
+
+// formattedArg returns a call argument that is the obj and is formatted
+// with a verb that invokes String or Error method.
+func (c *recursiveStringerChecker) formattedArg(call *ast.CallExpr, obj types.Object) ast.Expr {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+		return nil
+	}
+	args := call.Args
+	if strings.HasPrefix(fn.Name(), "F") && len(args) != 0 {
+		args = args[1:] // Skip the io.Writer
+	}
+
+	if !strings.HasSuffix(fn.Name(), "f") {
+		for _, arg := range args {
+			if c.isObj(arg, obj) {
+				return arg
+			}
+		}
+		return nil
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+	tv := c.ctx.TypesInfo.Types[args[0]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return nil
+	}
+	verbs := parseFormatVerbs(constant.StringVal(tv.Value))
+	for i, arg := range args[1:] {
+		if i >= len(verbs) || !c.isObj(arg, obj) {
+			continue
+		}
+		switch verbs[i] {
+		case 'v', 's', 'q', 'x', 'X':
+			return arg
+		}
+	}
+	return nil
+}
+
+// receiverArgs returns call arguments that are the obj.
+func (c *recursiveStringerChecker) receiverArgs(call *ast.CallExpr, obj types.Object) []ast.Expr {
+	var args []ast.Expr
+	for _, arg := range call.Args {
+		if c.isObj(arg, obj) {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// helperParam returns the called function declaration and a parameter
+// that receives arg, if the function is declared in the current file.
+func (c *recursiveStringerChecker) helperParam(call *ast.CallExpr, arg ast.Expr) (*ast.FuncDecl, types.Object) {
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Func)
+	if !ok {
+		return nil, nil
+	}
+	decl := c.funcs[fn]
+	if decl == nil {
+		return nil, nil
+	}
+	i := 0
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			if i < len(call.Args) && call.Args[i] == arg {
+				return decl, c.ctx.TypesInfo.ObjectOf(name)
+			}
+			i++
+		}
+	}
+	return nil, nil
+}
+
+func (c *recursiveStringerChecker) formatsParam(decl *ast.FuncDecl, param types.Object) bool {
+	if param == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && c.formattedArg(call, param) != nil {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// hasMethod reports whether the x type method set contains the name method,
+// so formatting x calls it. For example, *p where p is a pointer receiver
+// doesn't have String method if it's declared for the pointer type only.
+func (c *recursiveStringerChecker) hasMethod(x ast.Expr, name string) bool {
+	return types.NewMethodSet(c.ctx.TypeOf(x)).Lookup(nil, name) != nil
+}
+
+// isObj reports whether x is obj, *obj or &obj.
+func (c *recursiveStringerChecker) isObj(x ast.Expr, obj types.Object) bool {
+	switch e := x.(type) {
+	case *ast.StarExpr:
+		x = e.X
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			x = e.X
+		}
+	case *ast.ParenExpr:
+		return c.isObj(e.X, obj)
+	}
+	id, ok := x.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(id) == obj
+}
+
+func (c *recursiveStringerChecker) warn(cause ast.Expr, method, helper *ast.FuncDecl) {
+	if helper != nil {
+		c.ctx.Warn(cause, "%s method formats its receiver via %s, causing infinite recursion",
+			method.Name, helper.Name)
+		return
+	}
+	c.ctx.Warn(cause, "%s method formats its receiver, causing infinite recursion", method.Name)
+}
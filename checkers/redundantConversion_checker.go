@@ -0,0 +1,83 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "redundantConversion"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects conversions to the type the operand already has"
+	info.Details = `Constant operands are ignored, since the conversion may define the constant type.
+Float and complex conversions of expressions other than variables are ignored too,
+since an explicit conversion rounds the value and prevents fused multiply-add.`
+	info.Before = `
+var d time.Duration = timeout()
+deadline := time.Now().Add(time.Duration(d))`
+	info.After = `
+var d time.Duration = timeout()
+deadline := time.Now().Add(d)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&redundantConversionChecker{ctx: ctx})
+	})
+}
+
+type redundantConversionChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *redundantConversionChecker) VisitExpr(x ast.Expr) {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.Types[call.Fun]
+	if !ok || !fn.IsType() {
+		return
+	}
+	arg, ok := c.ctx.TypesInfo.Types[call.Args[0]]
+	if !ok || arg.Value != nil || arg.Type == nil {
+		return
+	}
+	if !types.Identical(arg.Type, fn.Type) {
+		return
+	}
+	if c.isFloat(arg.Type) {
+		if _, ok := astutil.Unparen(call.Args[0]).(*ast.Ident); !ok {
+			return
+		}
+	}
+	c.warn(call)
+}
+
+func (c *redundantConversionChecker) isFloat(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&(types.IsFloat|types.IsComplex) != 0
+}
+
+func (c *redundantConversionChecker) warn(cause *ast.CallExpr) {
+	arg := cause.Args[0]
+	s := astfmt.Sprint(arg)
+	switch arg.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.CallExpr, *ast.IndexExpr,
+		*ast.SliceExpr, *ast.ParenExpr, *ast.BasicLit, *ast.CompositeLit:
+	default:
+		// Keep the operators precedence.
+		s = "(" + s + ")"
+	}
+	fix := linter.QuickFix{
+		From:        cause.Pos(),
+		To:          cause.End(),
+		Replacement: []byte(s),
+	}
+	c.ctx.WarnFixable(cause, fix, "redundant conversion of %s to %s", arg, cause.Fun)
+}
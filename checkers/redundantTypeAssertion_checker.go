@@ -0,0 +1,77 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "redundantTypeAssertion"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects type assertions to the static type and discarded comma-ok results"
+	info.Before = `
+var r io.Reader = open()
+rr := r.(io.Reader)
+f, _ := r.(*os.File)`
+	info.After = `
+var r io.Reader = open()
+rr := r
+f, ok := r.(*os.File)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&redundantTypeAssertionChecker{ctx: ctx})
+	})
+}
+
+type redundantTypeAssertionChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *redundantTypeAssertionChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.TypeAssertExpr:
+			c.checkAssert(n)
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 2 && len(n.Rhs) == 1 {
+				c.checkCommaOk(n.Lhs[1], n.Rhs[0])
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) == 2 && len(n.Values) == 1 {
+				c.checkCommaOk(n.Names[1], n.Values[0])
+			}
+		}
+		return true
+	})
+}
+
+func (c *redundantTypeAssertionChecker) checkAssert(assert *ast.TypeAssertExpr) {
+	if assert.Type == nil {
+		return // x.(type)
+	}
+	typ := c.ctx.TypeOf(assert.Type)
+	if typ != linter.UnknownType && types.Identical(c.ctx.TypeOf(assert.X), typ) {
+		c.warnRedundant(assert)
+	}
+}
+
+func (c *redundantTypeAssertionChecker) checkCommaOk(ok, rhs ast.Expr) {
+	assert, isAssert := astutil.Unparen(rhs).(*ast.TypeAssertExpr)
+	if isAssert && identOf(ok) != nil && identOf(ok).Name == "_" {
+		c.warnDiscardedOk(assert)
+	}
+}
+
+func (c *redundantTypeAssertionChecker) warnRedundant(cause *ast.TypeAssertExpr) {
+	c.ctx.Warn(cause, "redundant type assertion: %s already has %s type", cause.X, cause.Type)
+}
+
+func (c *redundantTypeAssertionChecker) warnDiscardedOk(cause *ast.TypeAssertExpr) {
+	c.ctx.Warn(cause, "ok result of %s is discarded; failed assertion silently yields zero value", cause)
+}
@@ -3,9 +3,11 @@ package checkers
 import (
 	"go/ast"
 	"go/token"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
 	"golang.org/x/tools/go/ast/astutil"
 )
 
@@ -23,6 +25,8 @@ case int:
 if x, ok := x.(int); ok {
 	body()
 }`
+	info.Note = `Quick fix is not suggested when the switch has comments outside
+of its case body or when the type switch has an init statement.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		return astwalk.WalkerForStmt(&singleCaseSwitchChecker{ctx: ctx})
@@ -32,6 +36,13 @@ if x, ok := x.(int); ok {
 type singleCaseSwitchChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
+
+	file *ast.File
+}
+
+func (c *singleCaseSwitchChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
 }
 
 func (c *singleCaseSwitchChecker) VisitStmt(stmt ast.Stmt) {
@@ -55,10 +66,104 @@ func (c *singleCaseSwitchChecker) checkSwitchStmt(stmt ast.Stmt, body *ast.Block
 	case cc.List == nil:
 		c.warnDefault(stmt)
 	case len(cc.List) == 1:
-		c.warn(stmt)
+		fix, ok := c.suggestIf(stmt, cc)
+		if !ok {
+			c.warn(stmt)
+			return
+		}
+		c.warnFixable(stmt, fix)
 	}
 }
 
+// suggestIf returns a fix that rewrites a single case switch to if statement.
+// Case body is copied from the source, so its comments are preserved.
+func (c *singleCaseSwitchChecker) suggestIf(stmt ast.Stmt, cc *ast.CaseClause) (linter.QuickFix, bool) {
+	for _, cg := range c.file.Comments {
+		if cg.Pos() > stmt.Pos() && cg.End() < cc.Colon {
+			return linter.QuickFix{}, false
+		}
+	}
+	paren := func(x ast.Expr) ast.Expr {
+		if x, ok := x.(*ast.BinaryExpr); ok && x.Op.Precedence() <= token.EQL.Precedence() {
+			return &ast.ParenExpr{X: x}
+		}
+		return x
+	}
+
+	var init ast.Stmt
+	var cond ast.Expr
+	var body *ast.BlockStmt
+	switch stmt := stmt.(type) {
+	case *ast.SwitchStmt:
+		body = stmt.Body
+		init = stmt.Init
+		if stmt.Tag == nil {
+			cond = cc.List[0]
+		} else {
+			cond = &ast.BinaryExpr{X: paren(stmt.Tag), Op: token.EQL, Y: paren(cc.List[0])}
+		}
+	case *ast.TypeSwitchStmt:
+		body = stmt.Body
+		if stmt.Init != nil || c.usesOK(cc) {
+			return linter.QuickFix{}, false
+		}
+		if id, ok := cc.List[0].(*ast.Ident); ok && id.Name == "nil" {
+			return linter.QuickFix{}, false
+		}
+		// x := y.(type) => x, ok := y.(T)
+		// y.(type)      => _, ok := y.(T)
+		var lhs ast.Expr = &ast.Ident{Name: "_"}
+		var assert *ast.TypeAssertExpr
+		switch assign := stmt.Assign.(type) {
+		case *ast.AssignStmt:
+			lhs = assign.Lhs[0]
+			assert = assign.Rhs[0].(*ast.TypeAssertExpr)
+		case *ast.ExprStmt:
+			assert = assign.X.(*ast.TypeAssertExpr)
+		}
+		ok := &ast.Ident{Name: "ok"}
+		init = &ast.AssignStmt{
+			Lhs: []ast.Expr{lhs, ok},
+			Tok: token.DEFINE,
+			Rhs: []ast.Expr{&ast.TypeAssertExpr{X: assert.X, Type: cc.List[0]}},
+		}
+		cond = ok
+	}
+
+	caseBody := c.ctx.SourceText(cc.Colon+1, body.Rbrace)
+	if caseBody == nil {
+		return linter.QuickFix{}, false
+	}
+	var buf strings.Builder
+	buf.WriteString("if ")
+	if init != nil {
+		buf.WriteString(astfmt.Sprint(init) + "; ")
+	}
+	buf.WriteString(astfmt.Sprint(cond) + " {")
+	buf.Write(caseBody)
+	buf.WriteString("}")
+
+	fix := linter.QuickFix{
+		From:        stmt.Pos(),
+		To:          stmt.End(),
+		Replacement: []byte(buf.String()),
+	}
+	return fix, true
+}
+
+// usesOK reports whether cc refers to ok identifier
+// that would be shadowed by the comma-ok type assertion.
+func (c *singleCaseSwitchChecker) usesOK(cc *ast.CaseClause) bool {
+	found := false
+	ast.Inspect(cc, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == "ok" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
 func (c *singleCaseSwitchChecker) hasBreak(stmt ast.Stmt) bool {
 	found := false
 	astutil.Apply(stmt, func(cur *astutil.Cursor) bool {
@@ -79,6 +184,10 @@ func (c *singleCaseSwitchChecker) warn(stmt ast.Stmt) {
 	c.ctx.Warn(stmt, "should rewrite switch statement to if statement")
 }
 
+func (c *singleCaseSwitchChecker) warnFixable(stmt ast.Stmt, fix linter.QuickFix) {
+	c.ctx.WarnFixable(stmt, fix, "should rewrite switch statement to if statement")
+}
+
 func (c *singleCaseSwitchChecker) warnDefault(stmt ast.Stmt) {
 	c.ctx.Warn(stmt, "found switch with default case only")
 }
@@ -0,0 +1,330 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "slicesPkgSuggest"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects hand-written loops and sort calls that can use the slices package"
+	info.Details = "Suggests slices.Contains, slices.Index, slices.Equal and slices.Sort. Only reported for Go 1.21 and later."
+	info.Before = `
+for _, x := range xs {
+	if x == v {
+		return true
+	}
+}
+return false`
+	info.After = `return slices.Contains(xs, v)`
+	info.Note = "Quick fixes are only suggested in files that already import slices package."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &slicesPkgSuggestChecker{ctx: ctx}
+		return astwalk.WalkerForStmtList(c)
+	})
+}
+
+type slicesPkgSuggestChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// slicesPkg is a name under which "slices" package
+	// is imported in the current file; empty if it's not imported.
+	slicesPkg string
+}
+
+func (c *slicesPkgSuggestChecker) EnterFile(f *ast.File) bool {
+	c.slicesPkg = importedPkgName(f, "slices")
+	return c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21})
+}
+
+func (c *slicesPkgSuggestChecker) VisitStmtList(list []ast.Stmt) {
+	for i, stmt := range list {
+		switch stmt := stmt.(type) {
+		case *ast.RangeStmt:
+			if i+1 < len(list) {
+				c.checkSearchLoop(stmt, list[i+1])
+			}
+		case *ast.IfStmt:
+			if i+2 < len(list) {
+				c.checkEqualLoop(stmt, list[i+1], list[i+2])
+			}
+		case *ast.ExprStmt:
+			c.checkSortCall(stmt)
+		}
+	}
+}
+
+// checkSearchLoop handles slices.Contains and slices.Index patterns:
+//
+//	for _, x := range xs { if x == v { return true } }; return false
+//	for i, x := range xs { if x == v { return i } }; return -1
+func (c *slicesPkgSuggestChecker) checkSearchLoop(loop *ast.RangeStmt, next ast.Stmt) {
+	if !c.isSlice(loop.X) || len(loop.Body.List) != 1 {
+		return
+	}
+	ifStmt, ok := loop.Body.List[0].(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil || ifStmt.Else != nil || len(ifStmt.Body.List) != 1 {
+		return
+	}
+	cond := astcast.ToBinaryExpr(ifStmt.Cond)
+	if cond.Op != token.EQL {
+		return
+	}
+	var needle ast.Expr
+	switch {
+	case c.isRangeElem(loop, cond.X):
+		needle = cond.Y
+	case c.isRangeElem(loop, cond.Y):
+		needle = cond.X
+	default:
+		return
+	}
+	if !c.isLoopInvariant(loop, needle) || !c.isElemCompatible(loop.X, needle) {
+		return
+	}
+
+	inner := astcast.ToReturnStmt(ifStmt.Body.List[0])
+	outer := astcast.ToReturnStmt(next)
+	if len(inner.Results) != 1 || len(outer.Results) != 1 {
+		return
+	}
+	var fn string
+	switch {
+	case c.isRangeKey(loop, inner.Results[0]) && c.isConst(outer.Results[0], "-1"):
+		fn = "Index"
+	case c.isConst(inner.Results[0], "true") && c.isConst(outer.Results[0], "false"):
+		fn = "Contains"
+	default:
+		return
+	}
+	c.warn(loop, next.End(), &ast.ReturnStmt{
+		Results: []ast.Expr{c.slicesCall(fn, loop.X, needle)},
+	})
+}
+
+// checkEqualLoop handles slices.Equal pattern:
+//
+//	if len(a) != len(b) { return false }
+//	for i := range a { if a[i] != b[i] { return false } }
+//	return true
+func (c *slicesPkgSuggestChecker) checkEqualLoop(lenCheck *ast.IfStmt, next, last ast.Stmt) {
+	if lenCheck.Init != nil || lenCheck.Else != nil || !c.returnsConst(lenCheck.Body.List, "false") {
+		return
+	}
+	cond := astcast.ToBinaryExpr(lenCheck.Cond)
+	if cond.Op != token.NEQ {
+		return
+	}
+	lenX := astcast.ToCallExpr(cond.X)
+	lenY := astcast.ToCallExpr(cond.Y)
+	if qualifiedName(lenX.Fun) != "len" || qualifiedName(lenY.Fun) != "len" || len(lenX.Args) != 1 || len(lenY.Args) != 1 {
+		return
+	}
+	a, b := lenX.Args[0], lenY.Args[0]
+	if !c.isSlice(a) || !types.Identical(c.ctx.TypeOf(a), c.ctx.TypeOf(b)) {
+		return
+	}
+	if !typep.SideEffectFree(c.ctx.TypesInfo, b) {
+		return
+	}
+
+	loop, ok := next.(*ast.RangeStmt)
+	if !ok || loop.Key == nil || !astequal.Expr(loop.X, a) || len(loop.Body.List) != 1 {
+		return
+	}
+	ifStmt, ok := loop.Body.List[0].(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil || ifStmt.Else != nil || !c.returnsConst(ifStmt.Body.List, "false") {
+		return
+	}
+	elemCmp := astcast.ToBinaryExpr(ifStmt.Cond)
+	if elemCmp.Op != token.NEQ {
+		return
+	}
+	otherElem := astcast.ToIndexExpr(elemCmp.Y)
+	if !c.isRangeElem(loop, elemCmp.X) || !astequal.Expr(otherElem.X, b) || !c.isRangeKey(loop, otherElem.Index) {
+		return
+	}
+	if !c.returnsConst([]ast.Stmt{last}, "true") {
+		return
+	}
+	if _, ok := c.elemType(a).Underlying().(*types.Basic); !ok {
+		return // Avoid suggesting Equal for comparisons that may panic
+	}
+
+	c.warn(lenCheck, last.End(), &ast.ReturnStmt{
+		Results: []ast.Expr{c.slicesCall("Equal", a, b)},
+	})
+}
+
+// checkSortCall handles sort.Ints-like calls and
+// sort.Slice calls with a trivial less function.
+func (c *slicesPkgSuggestChecker) checkSortCall(stmt *ast.ExprStmt) {
+	call := astcast.ToCallExpr(stmt.X)
+	switch qualifiedName(call.Fun) {
+	case "sort.Ints", "sort.Strings", "sort.Float64s":
+		if len(call.Args) != 1 {
+			return
+		}
+	case "sort.Slice":
+		if len(call.Args) != 2 || !c.isSlice(call.Args[0]) || !c.isTrivialLess(call.Args[0], call.Args[1]) {
+			return
+		}
+		if typ, ok := c.elemType(call.Args[0]).Underlying().(*types.Basic); !ok || typ.Info()&types.IsOrdered == 0 {
+			return
+		}
+	default:
+		return
+	}
+	if !typep.SideEffectFree(c.ctx.TypesInfo, call.Args[0]) {
+		return
+	}
+	c.warn(stmt, stmt.End(), &ast.ExprStmt{X: c.slicesCall("Sort", call.Args[0])})
+}
+
+// isTrivialLess reports whether fn is `func(i, j int) bool { return xs[i] < xs[j] }`.
+func (c *slicesPkgSuggestChecker) isTrivialLess(xs, fn ast.Expr) bool {
+	lit, ok := fn.(*ast.FuncLit)
+	if !ok || len(lit.Body.List) != 1 {
+		return false
+	}
+	var params []*ast.Ident
+	for _, field := range lit.Type.Params.List {
+		params = append(params, field.Names...)
+	}
+	if len(params) != 2 {
+		return false
+	}
+	ret := astcast.ToReturnStmt(lit.Body.List[0])
+	if len(ret.Results) != 1 {
+		return false
+	}
+	cmp := astcast.ToBinaryExpr(astutil.Unparen(ret.Results[0]))
+	if cmp.Op != token.LSS {
+		return false
+	}
+	x := astcast.ToIndexExpr(cmp.X)
+	y := astcast.ToIndexExpr(cmp.Y)
+	return astequal.Expr(x.X, xs) && astequal.Expr(y.X, xs) &&
+		astcast.ToIdent(x.Index).Name == params[0].Name &&
+		astcast.ToIdent(y.Index).Name == params[1].Name
+}
+
+func (c *slicesPkgSuggestChecker) isSlice(x ast.Expr) bool {
+	_, ok := c.ctx.TypeOf(x).Underlying().(*types.Slice)
+	return ok
+}
+
+func (c *slicesPkgSuggestChecker) elemType(x ast.Expr) types.Type {
+	return c.ctx.TypeOf(x).Underlying().(*types.Slice).Elem()
+}
+
+// isElemCompatible reports whether needle can be passed as
+// slices.Contains or slices.Index argument for the xs slice.
+func (c *slicesPkgSuggestChecker) isElemCompatible(xs, needle ast.Expr) bool {
+	elem := c.elemType(xs)
+	if !types.Comparable(elem) {
+		return false
+	}
+	if c.ctx.TypesInfo.Types[needle].Value != nil {
+		return types.AssignableTo(c.ctx.TypeOf(needle), elem)
+	}
+	return types.Identical(c.ctx.TypeOf(needle), elem)
+}
+
+// isRangeElem reports whether x is a loop value variable or `xs[key]`.
+func (c *slicesPkgSuggestChecker) isRangeElem(loop *ast.RangeStmt, x ast.Expr) bool {
+	if loop.Value != nil {
+		return c.sameObject(loop.Value, x)
+	}
+	index := astcast.ToIndexExpr(x)
+	return loop.Key != nil && astequal.Expr(index.X, loop.X) && c.sameObject(loop.Key, index.Index)
+}
+
+func (c *slicesPkgSuggestChecker) isRangeKey(loop *ast.RangeStmt, x ast.Expr) bool {
+	return loop.Key != nil && c.sameObject(loop.Key, x)
+}
+
+func (c *slicesPkgSuggestChecker) sameObject(x, y ast.Expr) bool {
+	idX := astcast.ToIdent(x)
+	idY := astcast.ToIdent(y)
+	if idX.Name == "_" || idX.Name != idY.Name {
+		return false
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(idX)
+	return obj != nil && obj == c.ctx.TypesInfo.ObjectOf(idY)
+}
+
+// isLoopInvariant reports whether x doesn't depend on loop variables.
+func (c *slicesPkgSuggestChecker) isLoopInvariant(loop *ast.RangeStmt, x ast.Expr) bool {
+	if !typep.SideEffectFree(c.ctx.TypesInfo, x) {
+		return false
+	}
+	dependent := false
+	ast.Inspect(x, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		for _, v := range []ast.Expr{loop.Key, loop.Value} {
+			if v != nil && c.sameObject(v, id) {
+				dependent = true
+			}
+		}
+		return !dependent
+	})
+	return !dependent
+}
+
+func (c *slicesPkgSuggestChecker) isConst(x ast.Expr, s string) bool {
+	tv := c.ctx.TypesInfo.Types[x]
+	return tv.Value != nil && tv.Value.ExactString() == s
+}
+
+func (c *slicesPkgSuggestChecker) returnsConst(list []ast.Stmt, s string) bool {
+	if len(list) != 1 {
+		return false
+	}
+	ret := astcast.ToReturnStmt(list[0])
+	return len(ret.Results) == 1 && c.isConst(ret.Results[0], s)
+}
+
+func (c *slicesPkgSuggestChecker) slicesCall(fn string, args ...ast.Expr) *ast.CallExpr {
+	pkg := c.slicesPkg
+	if pkg == "" {
+		pkg = "slices"
+	}
+	return &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   &ast.Ident{Name: pkg},
+			Sel: &ast.Ident{Name: fn},
+		},
+		Args: args,
+	}
+}
+
+func (c *slicesPkgSuggestChecker) warn(cause ast.Stmt, end token.Pos, suggestion ast.Stmt) {
+	s := astfmt.Sprint(suggestion)
+	if c.slicesPkg == "" {
+		c.ctx.Warn(cause, "could replace with %s", s)
+		return
+	}
+	fix := linter.QuickFix{
+		From:        cause.Pos(),
+		To:          end,
+		Replacement: []byte(s),
+	}
+	c.ctx.WarnFixable(cause, fix, "could replace with %s", s)
+}
@@ -2,11 +2,11 @@ package checkers
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
-	"github.com/go-toolsmith/astcopy"
 	"github.com/go-toolsmith/astfmt"
 )
 
@@ -14,7 +14,14 @@ func init() {
 	var info linter.CheckerInfo
 	info.Name = "sloppyLen"
 	info.Tags = []string{"style"}
+	info.Params = linter.CheckerParams{
+		"checkConsistency": {
+			Value: false,
+			Usage: "whether to warn about mixing len(s) > 0 and len(s) != 0 styles in a single file",
+		},
+	}
 	info.Summary = "Detects usage of `len` when result is obvious or doesn't make sense"
+	info.Details = "Both `len` and `cap` results are checked, comparisons with negative constants are always true or always false."
 	info.Before = `
 len(arr) >= 0 // Sloppy
 len(arr) <= 0 // Sloppy
@@ -24,13 +31,30 @@ len(arr) > 0
 len(arr) == 0`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
-		return astwalk.WalkerForExpr(&sloppyLenChecker{ctx: ctx})
+		return astwalk.WalkerForExpr(&sloppyLenChecker{
+			ctx:              ctx,
+			checkConsistency: info.Params.Bool("checkConsistency"),
+		})
 	})
 }
 
 type sloppyLenChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
+
+	checkConsistency bool
+
+	// nonEmptyOp is a prevailing operator of the len(s) > 0
+	// and len(s) != 0 checks in the current file.
+	nonEmptyOp token.Token
+}
+
+func (c *sloppyLenChecker) EnterFile(f *ast.File) bool {
+	c.nonEmptyOp = token.ILLEGAL
+	if c.checkConsistency {
+		c.nonEmptyOp = c.prevailingNonEmptyOp(f)
+	}
+	return true
 }
 
 func (c *sloppyLenChecker) VisitExpr(x ast.Expr) {
@@ -39,34 +63,104 @@ func (c *sloppyLenChecker) VisitExpr(x ast.Expr) {
 		return
 	}
 
-	if expr.Op == token.LSS || expr.Op == token.GEQ || expr.Op == token.LEQ {
-		if c.isLenCall(expr.X) && c.isZero(expr.Y) {
-			c.warn(expr)
+	// Normalize `0 > len(s)` to `len(s) < 0`.
+	call, op, y := expr.X, expr.Op, expr.Y
+	if !c.isLenCall(call) && c.isLenCall(y) {
+		call, op, y = y, c.flipOp(op), call
+	}
+	if !c.isLenCall(call) {
+		return
+	}
+	v := c.ctx.TypesInfo.Types[y].Value
+	if v == nil || v.Kind() != constant.Int {
+		return
+	}
+
+	switch sign := constant.Sign(v); {
+	case sign < 0:
+		switch op {
+		case token.GTR, token.GEQ, token.NEQ:
+			c.warnAlways(expr, true)
+		case token.LSS, token.LEQ, token.EQL:
+			c.warnAlways(expr, false)
+		}
+	case sign == 0:
+		switch op {
+		case token.GEQ:
+			c.warnAlways(expr, true)
+		case token.LSS:
+			c.warnAlways(expr, false)
+		case token.LEQ:
+			c.warnCanBe(expr, &ast.BinaryExpr{X: call, Op: token.EQL, Y: y})
+		case token.GTR, token.NEQ:
+			if c.nonEmptyOp != token.ILLEGAL && op != c.nonEmptyOp && call == expr.X {
+				c.warnInconsistent(expr, &ast.BinaryExpr{X: call, Op: c.nonEmptyOp, Y: y})
+			}
+		}
+	}
+}
+
+// prevailingNonEmptyOp returns the most used operator of the
+// len(s) > 0 and len(s) != 0 checks inside f.
+// Ties are resolved in favor of the first operator used.
+func (c *sloppyLenChecker) prevailingNonEmptyOp(f *ast.File) token.Token {
+	counts := make(map[token.Token]int)
+	first := token.ILLEGAL
+	ast.Inspect(f, func(n ast.Node) bool {
+		expr, ok := n.(*ast.BinaryExpr)
+		if !ok || (expr.Op != token.GTR && expr.Op != token.NEQ) || !c.isLenCall(expr.X) {
+			return true
 		}
+		if v := c.ctx.TypesInfo.Types[expr.Y].Value; v == nil || constant.Sign(v) != 0 {
+			return true
+		}
+		if first == token.ILLEGAL {
+			first = expr.Op
+		}
+		counts[expr.Op]++
+		return true
+	})
+	if counts[token.GTR] > counts[token.NEQ] {
+		return token.GTR
+	}
+	if counts[token.NEQ] > counts[token.GTR] {
+		return token.NEQ
+	}
+	return first
+}
+
+func (c *sloppyLenChecker) flipOp(op token.Token) token.Token {
+	switch op {
+	case token.LSS:
+		return token.GTR
+	case token.GTR:
+		return token.LSS
+	case token.LEQ:
+		return token.GEQ
+	case token.GEQ:
+		return token.LEQ
+	default:
+		return op
 	}
 }
 
 func (c *sloppyLenChecker) isLenCall(x ast.Expr) bool {
 	call, ok := x.(*ast.CallExpr)
-	return ok && qualifiedName(call.Fun) == "len" && len(call.Args) == 1
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	name := qualifiedName(call.Fun)
+	return name == "len" || name == "cap"
 }
 
-func (c *sloppyLenChecker) isZero(x ast.Expr) bool {
-	value, ok := x.(*ast.BasicLit)
-	return ok && value.Value == "0"
+func (c *sloppyLenChecker) warnAlways(cause *ast.BinaryExpr, result bool) {
+	c.ctx.Warn(cause, "%s is always %v", cause, result)
 }
 
-func (c *sloppyLenChecker) warn(cause *ast.BinaryExpr) {
-	info := ""
-	switch cause.Op {
-	case token.LSS:
-		info = "is always false"
-	case token.GEQ:
-		info = "is always true"
-	case token.LEQ:
-		expr := astcopy.BinaryExpr(cause)
-		expr.Op = token.EQL
-		info = astfmt.Sprintf("can be %s", expr)
-	}
-	c.ctx.Warn(cause, "%s %s", cause, info)
+func (c *sloppyLenChecker) warnCanBe(cause, suggestion *ast.BinaryExpr) {
+	c.ctx.Warn(cause, "%s can be %s", cause, astfmt.Sprint(suggestion))
+}
+
+func (c *sloppyLenChecker) warnInconsistent(cause, suggestion *ast.BinaryExpr) {
+	c.ctx.Warn(cause, "%s can be %s for consistency with the rest of the file", cause, astfmt.Sprint(suggestion))
 }
@@ -0,0 +1,143 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "sprintfConcat"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects fmt.Sprintf calls that can be replaced with a string concatenation"
+	info.Details = "Only plain %s and %v verbs with string arguments are considered."
+	info.Before = `key := fmt.Sprintf("user-%s", id)`
+	info.After = `key := "user-" + id`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&sprintfConcatChecker{ctx: ctx})
+	})
+}
+
+type sprintfConcatChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// operands are calls that are operands of the expressions
+	// that bind tighter than +, so the concatenation needs parens.
+	// Parents are visited before their operands.
+	operands map[*ast.CallExpr]bool
+}
+
+func (c *sprintfConcatChecker) EnterFile(f *ast.File) bool {
+	c.operands = make(map[*ast.CallExpr]bool)
+	return true
+}
+
+func (c *sprintfConcatChecker) VisitExpr(x ast.Expr) {
+	switch x := x.(type) {
+	case *ast.IndexExpr:
+		c.markOperand(x.X)
+	case *ast.SliceExpr:
+		c.markOperand(x.X)
+	case *ast.SelectorExpr:
+		c.markOperand(x.X)
+	case *ast.UnaryExpr:
+		c.markOperand(x.X)
+	case *ast.BinaryExpr:
+		if x.Op.Precedence() > token.ADD.Precedence() {
+			c.markOperand(x.X)
+			c.markOperand(x.Y)
+		}
+	}
+
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.FullName() != "fmt.Sprintf" {
+		return
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	format, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+	segments, ok := c.splitFormat(format)
+	if !ok || len(segments)-1 != len(call.Args)-1 {
+		return
+	}
+	args := call.Args[1:]
+	for _, arg := range args {
+		if !types.Identical(c.ctx.TypeOf(arg), types.Typ[types.String]) {
+			return
+		}
+	}
+
+	var parts []string
+	for i, seg := range segments {
+		if seg != "" {
+			parts = append(parts, strconv.Quote(seg))
+		}
+		if i < len(args) {
+			parts = append(parts, astfmt.Sprint(args[i]))
+		}
+	}
+	if len(parts) < 2 {
+		// `fmt.Sprintf("%s", s)` is handled by other checkers.
+		return
+	}
+	suggestion := strings.Join(parts, " + ")
+	if c.operands[call] {
+		suggestion = "(" + suggestion + ")"
+	}
+	c.warn(call, suggestion)
+}
+
+func (c *sprintfConcatChecker) markOperand(x ast.Expr) {
+	if call, ok := x.(*ast.CallExpr); ok {
+		c.operands[call] = true
+	}
+}
+
+// splitFormat splits format into literal segments separated by
+// the plain %s or %v verbs. Returns false if format contains
+// any other verbs or escaped percent signs.
+func (c *sprintfConcatChecker) splitFormat(format string) ([]string, bool) {
+	var segments []string
+	for {
+		i := strings.IndexByte(format, '%')
+		if i == -1 {
+			return append(segments, format), true
+		}
+		if i+1 >= len(format) || (format[i+1] != 's' && format[i+1] != 'v') {
+			return nil, false
+		}
+		segments = append(segments, format[:i])
+		format = format[i+2:]
+	}
+}
+
+func (c *sprintfConcatChecker) warn(cause *ast.CallExpr, suggestion string) {
+	fix := linter.QuickFix{
+		From:        cause.Pos(),
+		To:          cause.End(),
+		Replacement: []byte(suggestion),
+	}
+	c.ctx.WarnFixable(cause, fix, "could replace with %s", suggestion)
+}
@@ -0,0 +1,165 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "sqlQueryConcat"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects SQL queries built from non-constant values with concatenation or fmt.Sprintf"
+	info.Details = `Values embedded into a query string are not escaped and open the door to SQL injection.
+Query, Exec and Prepare calls (and their Context variants) are checked, including
+queries that are built in a local variable first.`
+	info.Before = `rows, err := db.Query("SELECT * FROM users WHERE name = '" + name + "'")`
+	info.After = `rows, err := db.Query("SELECT * FROM users WHERE name = $1", name)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&sqlQueryConcatChecker{ctx: ctx})
+	})
+}
+
+type sqlQueryConcatChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// assigned maps function-local variables to the expressions
+	// that were assigned to them.
+	assigned map[types.Object][]ast.Expr
+}
+
+func (c *sqlQueryConcatChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	c.assigned = make(map[types.Object][]ast.Expr)
+	var calls []*ast.CallExpr
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			c.collectAssign(n)
+		case *ast.ValueSpec:
+			if len(n.Names) == len(n.Values) {
+				for i, id := range n.Names {
+					c.addAssigned(id, n.Values[i])
+				}
+			}
+		case *ast.CallExpr:
+			calls = append(calls, n)
+		}
+		return true
+	})
+
+	for _, call := range calls {
+		query := c.queryArg(call)
+		if query == nil {
+			continue
+		}
+		if how := c.buildKind(query, true); how != "" {
+			c.warn(query, call, how)
+		}
+	}
+}
+
+func (c *sqlQueryConcatChecker) collectAssign(assign *ast.AssignStmt) {
+	if len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, lhs := range assign.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		rhs := assign.Rhs[i]
+		if assign.Tok == token.ADD_ASSIGN {
+			// Treat `q += x` like `q = q + x`.
+			rhs = &ast.BinaryExpr{X: id, Op: token.ADD, Y: rhs}
+		}
+		c.addAssigned(id, rhs)
+	}
+}
+
+func (c *sqlQueryConcatChecker) addAssigned(id *ast.Ident, x ast.Expr) {
+	if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+		c.assigned[obj] = append(c.assigned[obj], x)
+	}
+}
+
+// queryArg returns the query argument of a database Query/Exec/Prepare call.
+// Nil is returned if call is not one of them.
+func (c *sqlQueryConcatChecker) queryArg(call *ast.CallExpr) ast.Expr {
+	sel := astcast.ToSelectorExpr(call.Fun)
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return nil
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil {
+		return nil
+	}
+	index := 0
+	switch fn.Name() {
+	case "Query", "QueryRow", "Exec", "Prepare":
+	case "QueryContext", "QueryRowContext", "ExecContext", "PrepareContext":
+		index = 1
+	default:
+		return nil
+	}
+	params := sig.Params()
+	if params.Len() <= index || len(call.Args) <= index {
+		return nil
+	}
+	if typ, ok := params.At(index).Type().(*types.Basic); !ok || typ.Kind() != types.String {
+		return nil
+	}
+	// To avoid false positives, require (query string, args ...interface{})-like
+	// signature for Query and Exec. Prepare has no args.
+	if fn.Name() != "Prepare" && fn.Name() != "PrepareContext" && !sig.Variadic() {
+		return nil
+	}
+	return call.Args[index]
+}
+
+// buildKind returns a description of how a string is built from
+// non-constant values or empty string if x is not built that way.
+func (c *sqlQueryConcatChecker) buildKind(x ast.Expr, followVars bool) string {
+	if c.ctx.TypesInfo.Types[x].Value != nil {
+		return ""
+	}
+	switch x := x.(type) {
+	case *ast.ParenExpr:
+		return c.buildKind(x.X, followVars)
+	case *ast.BinaryExpr:
+		if x.Op == token.ADD {
+			return "concatenation"
+		}
+	case *ast.CallExpr:
+		if qualifiedName(x.Fun) != "fmt.Sprintf" || len(x.Args) < 2 {
+			return ""
+		}
+		for _, arg := range x.Args[1:] {
+			if c.ctx.TypesInfo.Types[arg].Value == nil {
+				return "fmt.Sprintf"
+			}
+		}
+	case *ast.Ident:
+		if !followVars {
+			return ""
+		}
+		for _, rhs := range c.assigned[c.ctx.TypesInfo.ObjectOf(x)] {
+			if how := c.buildKind(rhs, false); how != "" {
+				return how
+			}
+		}
+	}
+	return ""
+}
+
+func (c *sqlQueryConcatChecker) warn(query ast.Expr, call *ast.CallExpr, how string) {
+	c.ctx.Warn(query, "query passed to %s is built with %s; use placeholders and pass values as query args",
+		astcast.ToSelectorExpr(call.Fun).Sel.Name, how)
+}
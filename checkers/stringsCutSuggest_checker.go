@@ -0,0 +1,200 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "stringsCutSuggest"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects strings.Index and strings.SplitN usages that can be replaced with strings.Cut"
+	info.Details = "Also handles bytes package equivalents. Only reported for Go 1.18 and later."
+	info.Before = `
+if i := strings.Index(s, "="); i != -1 {
+	key, value = s[:i], s[i+1:]
+}`
+	info.After = `
+if k, v, ok := strings.Cut(s, "="); ok {
+	key, value = k, v
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&stringsCutSuggestChecker{ctx: ctx})
+	})
+}
+
+type stringsCutSuggestChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *stringsCutSuggestChecker) EnterFile(f *ast.File) bool {
+	return c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 18})
+}
+
+func (c *stringsCutSuggestChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			c.checkAssign(decl.Body, assign)
+		}
+		return true
+	})
+}
+
+func (c *stringsCutSuggestChecker) checkAssign(body *ast.BlockStmt, assign *ast.AssignStmt) {
+	if assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	id := astcast.ToIdent(assign.Lhs[0])
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil {
+		return
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	fn := qualifiedName(call.Fun)
+	switch fn {
+	case "strings.Index", "bytes.Index":
+		if len(call.Args) == 2 && c.onlySlicingUses(body, obj, call.Args[0], call.Args[1]) {
+			c.warnIndex(call)
+		}
+	case "strings.SplitN", "bytes.SplitN":
+		if len(call.Args) == 3 && c.isIntConst(call.Args[2], 2) && c.onlyPairUses(body, obj) {
+			c.warnSplitN(call)
+		}
+	}
+}
+
+// onlySlicingUses reports whether index obj is only used to slice s
+// around the sep and in the not found checks, like `i == -1` or `i < 0`.
+// At least one such check is required, since strings.Cut reports it separately.
+func (c *stringsCutSuggestChecker) onlySlicingUses(body ast.Node, obj types.Object, s, sep ast.Expr) bool {
+	if !typep.SideEffectFree(c.ctx.TypesInfo, s) || !typep.SideEffectFree(c.ctx.TypesInfo, sep) {
+		return false
+	}
+	isIndex := func(x ast.Expr) bool {
+		id, ok := x.(*ast.Ident)
+		return ok && c.ctx.TypesInfo.ObjectOf(id) == obj
+	}
+	// isAfterSep reports whether x is `i+len(sep)` or
+	// `i+1` for a single byte sep.
+	isAfterSep := func(x ast.Expr) bool {
+		add := astcast.ToBinaryExpr(x)
+		if add.Op != token.ADD || !isIndex(add.X) {
+			return false
+		}
+		lenCall := astcast.ToCallExpr(add.Y)
+		if qualifiedName(lenCall.Fun) == "len" && len(lenCall.Args) == 1 {
+			return astequal.Expr(lenCall.Args[0], sep)
+		}
+		return c.isIntConst(add.Y, 1) && c.isSingleByteConst(sep)
+	}
+
+	allowed := make(map[*ast.Ident]bool)
+	slices := 0
+	guards := 0
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SliceExpr:
+			if n.Slice3 || !astequal.Expr(n.X, s) {
+				return true
+			}
+			switch {
+			case n.Low == nil && isIndex(n.High):
+				allowed[n.High.(*ast.Ident)] = true
+				slices++
+			case n.High == nil && isAfterSep(n.Low):
+				allowed[n.Low.(*ast.BinaryExpr).X.(*ast.Ident)] = true
+				slices++
+			}
+		case *ast.BinaryExpr:
+			if !isIndex(n.X) {
+				return true
+			}
+			isGuard := false
+			switch n.Op {
+			case token.EQL, token.NEQ:
+				isGuard = c.isIntConst(n.Y, -1)
+			case token.LSS, token.GEQ:
+				isGuard = c.isIntConst(n.Y, 0)
+			}
+			if isGuard {
+				allowed[n.X.(*ast.Ident)] = true
+				guards++
+			}
+		}
+		return true
+	})
+
+	return slices != 0 && guards != 0 && c.allUsesAllowed(body, obj, allowed)
+}
+
+// onlyPairUses reports whether obj slice is only used as `v[0]`, `v[1]` and `len(v)`.
+func (c *stringsCutSuggestChecker) onlyPairUses(body ast.Node, obj types.Object) bool {
+	isSlice := func(x ast.Expr) bool {
+		id, ok := x.(*ast.Ident)
+		return ok && c.ctx.TypesInfo.ObjectOf(id) == obj
+	}
+	allowed := make(map[*ast.Ident]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IndexExpr:
+			if isSlice(n.X) && (c.isIntConst(n.Index, 0) || c.isIntConst(n.Index, 1)) {
+				allowed[n.X.(*ast.Ident)] = true
+			}
+		case *ast.CallExpr:
+			if qualifiedName(n.Fun) == "len" && len(n.Args) == 1 && isSlice(n.Args[0]) {
+				allowed[n.Args[0].(*ast.Ident)] = true
+			}
+		}
+		return true
+	})
+	return c.allUsesAllowed(body, obj, allowed)
+}
+
+func (c *stringsCutSuggestChecker) allUsesAllowed(body ast.Node, obj types.Object, allowed map[*ast.Ident]bool) bool {
+	ok := true
+	ast.Inspect(body, func(n ast.Node) bool {
+		id, isIdent := n.(*ast.Ident)
+		if isIdent && c.ctx.TypesInfo.Uses[id] == obj && !allowed[id] {
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+func (c *stringsCutSuggestChecker) isIntConst(x ast.Expr, v int64) bool {
+	tv := c.ctx.TypesInfo.Types[x]
+	if tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return false
+	}
+	have, exact := constant.Int64Val(tv.Value)
+	return exact && have == v
+}
+
+func (c *stringsCutSuggestChecker) isSingleByteConst(x ast.Expr) bool {
+	tv := c.ctx.TypesInfo.Types[x]
+	return tv.Value != nil && tv.Value.Kind() == constant.String &&
+		len(constant.StringVal(tv.Value)) == 1
+}
+
+func (c *stringsCutSuggestChecker) warnIndex(call *ast.CallExpr) {
+	pkg := strings.TrimSuffix(qualifiedName(call.Fun), ".Index")
+	c.ctx.Warn(call, "use %s.Cut instead of %s and slicing", pkg, call)
+}
+
+func (c *stringsCutSuggestChecker) warnSplitN(call *ast.CallExpr) {
+	pkg := strings.TrimSuffix(qualifiedName(call.Fun), ".SplitN")
+	c.ctx.Warn(call, "use %s.Cut instead of %s", pkg, call)
+}
@@ -0,0 +1,170 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "structTagSyntax"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects malformed struct tags and misused options of well-known tag keys"
+	info.Details = `Reports bad key:"value" syntax, duplicated keys, unknown options
+for json, xml, yaml and db keys and json omitempty on struct-typed fields.`
+	info.Before = "type point struct {\n\tX int `json: \"x,omitmepty\"`\n}"
+	info.After = "type point struct {\n\tX int `json:\"x,omitempty\"`\n}"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForTypeExpr(&structTagSyntaxChecker{ctx: ctx}, ctx.TypesInfo)
+	})
+}
+
+type structTagSyntaxChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// knownTagOptions lists valid options for well-known struct tag keys.
+var knownTagOptions = map[string]map[string]bool{
+	"json": {"omitempty": true, "omitzero": true, "string": true},
+	"xml": {
+		"attr": true, "chardata": true, "cdata": true, "innerxml": true,
+		"comment": true, "any": true, "omitempty": true,
+	},
+	"yaml": {"omitempty": true, "flow": true, "inline": true},
+	"db":   {},
+}
+
+func (c *structTagSyntaxChecker) VisitTypeExpr(x ast.Expr) {
+	typ, ok := x.(*ast.StructType)
+	if !ok {
+		return
+	}
+	for _, field := range typ.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		c.checkTag(field, tag)
+	}
+}
+
+// checkTag parses a tag in the same way as reflect.StructTag.Lookup does,
+// but reports the errors instead of skipping the malformed parts.
+func (c *structTagSyntaxChecker) checkTag(field *ast.Field, tag string) {
+	seen := make(map[string]bool)
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			return
+		}
+
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' {
+			c.warnBadSyntax(field)
+			return
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		switch {
+		case tag[0] == ' ':
+			c.warnSpaceAfterColon(field, key)
+			return
+		case tag[0] != '"':
+			c.warnUnquoted(field, key)
+			return
+		}
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			c.warnBadSyntax(field)
+			return
+		}
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			c.warnBadSyntax(field)
+			return
+		}
+		tag = tag[i+1:]
+
+		if seen[key] {
+			c.warnDuplicate(field, key)
+		}
+		seen[key] = true
+		c.checkOptions(field, key, value)
+	}
+}
+
+func (c *structTagSyntaxChecker) checkOptions(field *ast.Field, key, value string) {
+	known, ok := knownTagOptions[key]
+	if !ok {
+		return
+	}
+	if value == "-" || value == "-," {
+		return
+	}
+	options := strings.Split(value, ",")[1:]
+	for _, opt := range options {
+		if !known[opt] {
+			c.warnUnknownOption(field, key, opt)
+		}
+	}
+	if key == "json" {
+		for _, opt := range options {
+			if opt == "omitempty" {
+				c.checkOmitEmpty(field)
+			}
+		}
+	}
+}
+
+// checkOmitEmpty reports omitempty on struct-typed fields, because
+// encoding/json never considers a struct value to be empty.
+func (c *structTagSyntaxChecker) checkOmitEmpty(field *ast.Field) {
+	if _, ok := c.ctx.TypeOf(field.Type).Underlying().(*types.Struct); ok {
+		c.warnOmitEmpty(field)
+	}
+}
+
+func (c *structTagSyntaxChecker) warnBadSyntax(field *ast.Field) {
+	c.ctx.Warn(field.Tag, "bad syntax for struct tag %s", field.Tag.Value)
+}
+
+func (c *structTagSyntaxChecker) warnSpaceAfterColon(field *ast.Field, key string) {
+	c.ctx.Warn(field.Tag, "%s struct tag key has a space after the colon", key)
+}
+
+func (c *structTagSyntaxChecker) warnUnquoted(field *ast.Field, key string) {
+	c.ctx.Warn(field.Tag, "%s struct tag key value is not quoted", key)
+}
+
+func (c *structTagSyntaxChecker) warnDuplicate(field *ast.Field, key string) {
+	c.ctx.Warn(field.Tag, "duplicated %s struct tag key", key)
+}
+
+func (c *structTagSyntaxChecker) warnUnknownOption(field *ast.Field, key, opt string) {
+	c.ctx.Warn(field.Tag, "unknown %s struct tag option %q", key, opt)
+}
+
+func (c *structTagSyntaxChecker) warnOmitEmpty(field *ast.Field) {
+	c.ctx.Warn(field.Tag, "omitempty has no effect on fields of struct type %s", field.Type)
+}
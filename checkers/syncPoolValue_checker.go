@@ -0,0 +1,123 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "syncPoolValue"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects non-pointer values stored in sync.Pool"
+	info.Details = `Converting a non-pointer value to interface{} allocates,
+so Put of such value costs an allocation the pool was meant to avoid.`
+	info.Before = `
+var bufPool = sync.Pool{
+	New: func() interface{} { return make([]byte, 0, 4096) },
+}
+bufPool.Put(buf[:0])`
+	info.After = `
+var bufPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 4096)
+		return &buf
+	},
+}
+bufPool.Put(bufPtr)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&syncPoolValueChecker{ctx: ctx})
+	})
+}
+
+type syncPoolValueChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *syncPoolValueChecker) VisitExpr(x ast.Expr) {
+	switch x := x.(type) {
+	case *ast.CallExpr:
+		c.checkPut(x)
+	case *ast.CompositeLit:
+		c.checkPoolLit(x)
+	}
+}
+
+func (c *syncPoolValueChecker) checkPut(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 1 {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.FullName() != "(*sync.Pool).Put" {
+		return
+	}
+	if typ := c.ctx.TypeOf(call.Args[0]); !c.isPointerLike(typ) {
+		c.warnPut(call.Args[0], typ)
+	}
+}
+
+func (c *syncPoolValueChecker) checkPoolLit(lit *ast.CompositeLit) {
+	if c.ctx.TypeOf(lit).String() != "sync.Pool" {
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv := astcast.ToKeyValueExpr(elt)
+		if astcast.ToIdent(kv.Key).Name != "New" {
+			continue
+		}
+		fn, ok := kv.Value.(*ast.FuncLit)
+		if !ok {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ReturnStmt:
+				if len(n.Results) != 1 {
+					break
+				}
+				if typ := c.ctx.TypeOf(n.Results[0]); !c.isPointerLike(typ) {
+					c.warnNew(n.Results[0], typ)
+				}
+			}
+			return true
+		})
+	}
+}
+
+// isPointerLike reports whether values of typ can be stored
+// inside interface without an allocation.
+// Interfaces and unknown types are reported as pointer-like too.
+func (c *syncPoolValueChecker) isPointerLike(typ types.Type) bool {
+	if typ == linter.UnknownType {
+		return true
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Pointer, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return true
+	case *types.Basic:
+		return typ.Kind() == types.UnsafePointer || typ.Kind() == types.UntypedNil
+	default:
+		return false
+	}
+}
+
+func (c *syncPoolValueChecker) warnPut(cause ast.Expr, typ types.Type) {
+	s := types.TypeString(typ, types.RelativeTo(c.ctx.Pkg))
+	c.ctx.Warn(cause, "non-pointer value of type %s passed to Put causes an allocation; store *%s instead",
+		s, s)
+}
+
+func (c *syncPoolValueChecker) warnNew(cause ast.Expr, typ types.Type) {
+	s := types.TypeString(typ, types.RelativeTo(c.ctx.Pkg))
+	c.ctx.Warn(cause, "New returns non-pointer value of type %s; return *%s to avoid allocations",
+		s, s)
+}
@@ -0,0 +1,117 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "testHelperMarker"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects test helpers that report failures but don't call t.Helper()"
+	info.Details = "Without t.Helper(), failure locations point at the helper instead of the test that called it."
+	info.Before = `
+func assertEqual(t *testing.T, x, y int) {
+	if x != y {
+		t.Fatalf("%d != %d", x, y)
+	}
+}`
+	info.After = `
+func assertEqual(t *testing.T, x, y int) {
+	t.Helper()
+	if x != y {
+		t.Fatalf("%d != %d", x, y)
+	}
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&testHelperMarkerChecker{ctx: ctx})
+	})
+}
+
+type testHelperMarkerChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *testHelperMarkerChecker) EnterFile(f *ast.File) bool {
+	return strings.HasSuffix(c.ctx.Filename, "_test.go")
+}
+
+func (c *testHelperMarkerChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	if fn.Body == nil {
+		return false
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz"} {
+		if strings.HasPrefix(fn.Name.Name, prefix) && fn.Type.Params.NumFields() == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *testHelperMarkerChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	for _, field := range decl.Type.Params.List {
+		if !c.isTestingType(c.ctx.TypeOf(field.Type)) {
+			continue
+		}
+		for _, id := range field.Names {
+			if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+				c.checkParam(decl, obj)
+			}
+		}
+	}
+}
+
+func (c *testHelperMarkerChecker) checkParam(decl *ast.FuncDecl, param types.Object) {
+	var report *ast.CallExpr
+	hasHelper := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			// Subtests and deferred calls are out of scope.
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); !ok || c.ctx.TypesInfo.Uses[id] != param {
+			return true
+		}
+		switch sel.Sel.Name {
+		case "Helper":
+			hasHelper = true
+		case "Error", "Errorf", "Fatal", "Fatalf":
+			if report == nil {
+				report = call
+			}
+		}
+		return true
+	})
+	if report != nil && !hasHelper {
+		c.warn(decl, param)
+	}
+}
+
+func (c *testHelperMarkerChecker) isTestingType(typ types.Type) bool {
+	switch typ.String() {
+	case "*testing.T", "*testing.B", "testing.TB":
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *testHelperMarkerChecker) warn(decl *ast.FuncDecl, param types.Object) {
+	c.ctx.Warn(decl.Name, "%s reports test failures, but doesn't call %s.Helper()",
+		decl.Name, param.Name())
+}
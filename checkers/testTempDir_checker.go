@@ -0,0 +1,164 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "testTempDir"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects manual temp dir and env var cleanups in tests that testing package can do"
+	info.Details = `Suggests t.TempDir() (Go 1.15) instead of a temp dir removed by defer
+and t.Setenv (Go 1.17) instead of os.Setenv with a deferred restore.`
+	info.Before = `
+dir, err := os.MkdirTemp("", "test")
+if err != nil {
+	t.Fatal(err)
+}
+defer os.RemoveAll(dir)`
+	info.After = `dir := t.TempDir()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&testTempDirChecker{ctx: ctx})
+	})
+}
+
+type testTempDirChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *testTempDirChecker) EnterFile(f *ast.File) bool {
+	return strings.HasSuffix(c.ctx.Filename, "_test.go") &&
+		c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 15})
+}
+
+func (c *testTempDirChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	t := c.testingParam(decl)
+	if t == nil {
+		return
+	}
+
+	var deferred []*ast.CallExpr
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if stmt, ok := n.(*ast.DeferStmt); ok {
+			deferred = append(deferred, c.deferredCalls(stmt)...)
+		}
+		return true
+	})
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.DeferStmt:
+			return false
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 2 && len(n.Rhs) == 1 {
+				c.checkTempDir(t, n.Lhs[0], n.Rhs[0], deferred)
+			}
+		case *ast.ExprStmt:
+			c.checkSetenv(t, astcast.ToCallExpr(n.X), deferred)
+		}
+		return true
+	})
+}
+
+// checkTempDir handles `dir, err := os.MkdirTemp(...)` followed by
+// `defer os.RemoveAll(dir)`.
+func (c *testTempDirChecker) checkTempDir(t *ast.Ident, dir, rhs ast.Expr, deferred []*ast.CallExpr) {
+	call := astcast.ToCallExpr(rhs)
+	switch c.funcName(call) {
+	case "os.MkdirTemp", "io/ioutil.TempDir":
+	default:
+		return
+	}
+	for _, d := range deferred {
+		if c.funcName(d) == "os.RemoveAll" && len(d.Args) == 1 && astequal.Expr(d.Args[0], dir) {
+			c.warnTempDir(call, t)
+			return
+		}
+	}
+}
+
+// checkSetenv handles `os.Setenv(key, v)` with a deferred
+// os.Setenv or os.Unsetenv call for the same key.
+func (c *testTempDirChecker) checkSetenv(t *ast.Ident, call *ast.CallExpr, deferred []*ast.CallExpr) {
+	if !c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 17}) {
+		return
+	}
+	if c.funcName(call) != "os.Setenv" || len(call.Args) != 2 {
+		return
+	}
+	for _, d := range deferred {
+		switch c.funcName(d) {
+		case "os.Setenv", "os.Unsetenv":
+			if astequal.Expr(d.Args[0], call.Args[0]) {
+				c.warnSetenv(call, t)
+				return
+			}
+		}
+	}
+}
+
+// deferredCalls returns calls that are executed by the defer statement.
+// For deferred function literals, all top-level calls of its body are returned.
+func (c *testTempDirChecker) deferredCalls(stmt *ast.DeferStmt) []*ast.CallExpr {
+	lit, ok := stmt.Call.Fun.(*ast.FuncLit)
+	if !ok {
+		return []*ast.CallExpr{stmt.Call}
+	}
+	var calls []*ast.CallExpr
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			calls = append(calls, call)
+		}
+		return true
+	})
+	return calls
+}
+
+func (c *testTempDirChecker) testingParam(decl *ast.FuncDecl) *ast.Ident {
+	for _, field := range decl.Type.Params.List {
+		switch c.ctx.TypeOf(field.Type).String() {
+		case "*testing.T", "*testing.B", "testing.TB":
+			for _, id := range field.Names {
+				if id.Name != "_" {
+					return id
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// funcName returns a package-qualified function name of the call,
+// like "os.RemoveAll".
+func (c *testTempDirChecker) funcName(call *ast.CallExpr) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return ""
+	}
+	return fn.Pkg().Path() + "." + fn.Name()
+}
+
+func (c *testTempDirChecker) warnTempDir(cause *ast.CallExpr, t *ast.Ident) {
+	c.ctx.Warn(cause, "use %s.TempDir() instead of %s with deferred os.RemoveAll", t, cause.Fun)
+}
+
+func (c *testTempDirChecker) warnSetenv(cause *ast.CallExpr, t *ast.Ident) {
+	c.ctx.Warn(cause, "use %s.Setenv instead of os.Setenv with deferred restore", t)
+}
@@ -37,3 +37,32 @@ func noWarnings3() {
 		xs = append(xs, 4)
 	}
 }
+
+func ifBranchesOK(xs, ys []string, ok bool) {
+	// OK: different slices.
+	if ok {
+		xs = append(xs, "yes")
+	} else {
+		ys = append(ys, "no")
+	}
+
+	// OK: different number of elements.
+	if ok {
+		xs = append(xs, "yes")
+	} else {
+		xs = append(xs, "no", "maybe")
+	}
+
+	// OK: no else branch.
+	if ok {
+		xs = append(xs, "yes")
+	}
+
+	// OK: branches do more than append.
+	if ok {
+		xs = append(xs, "yes")
+		println()
+	} else {
+		xs = append(xs, "no")
+	}
+}
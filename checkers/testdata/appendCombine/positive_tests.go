@@ -91,3 +91,21 @@ func warnings2() {
 	xs["k3"] = append(xs["k3"], 4)
 	xs["k2"] = append(xs["k2"], 5)
 }
+
+func separatedByComments(xs []string, ok bool) []string {
+	/*! can combine chain of 3 appends into one */
+	xs = append(xs, "a")
+
+	// Comments and blank lines don't break the chain.
+	xs = append(xs, "b")
+
+	xs = append(xs, "c")
+
+	/*! both if branches append to xs; can append a conditionally selected element once */
+	if ok {
+		xs = append(xs, "yes")
+	} else {
+		xs = append(xs, "no")
+	}
+	return xs
+}
@@ -0,0 +1,25 @@
+package checker_test
+
+import (
+	"sync/atomic"
+)
+
+type alignedStats struct {
+	hits    int64
+	misses  uint64
+	enabled bool
+	count   int32
+}
+
+type withPointer struct {
+	flag  bool
+	stats *alignedStats
+}
+
+func aligned(s *alignedStats, p withPointer, n int64) {
+	atomic.AddInt64(&s.hits, 1)
+	atomic.LoadUint64(&s.misses)
+	atomic.AddInt32(&s.count, 1)
+	atomic.AddInt64(&p.stats.hits, 1)
+	atomic.AddInt64(&n, 1)
+}
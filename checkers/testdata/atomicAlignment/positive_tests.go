@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"sync/atomic"
+)
+
+type stats struct {
+	enabled bool
+	hits    int64
+	misses  uint64
+}
+
+type wrapper struct {
+	id int64
+	stats
+}
+
+type outer struct {
+	n     int64
+	inner stats
+}
+
+func misaligned(s *stats, w *wrapper, o outer) {
+	/*! s.hits is not 64-bit aligned on 32-bit platforms (offset 4); move it to the struct beginning or use atomic.Int64 */
+	atomic.AddInt64(&s.hits, 1)
+
+	/*! s.misses is not 64-bit aligned on 32-bit platforms (offset 12); move it to the struct beginning or use atomic.Int64 */
+	atomic.LoadUint64(&s.misses)
+
+	/*! w.hits is not 64-bit aligned on 32-bit platforms (offset 12); move it to the struct beginning or use atomic.Int64 */
+	atomic.StoreInt64(&w.hits, 0)
+
+	/*! o.inner.hits is not 64-bit aligned on 32-bit platforms (offset 12); move it to the struct beginning or use atomic.Int64 */
+	atomic.CompareAndSwapInt64(&o.inner.hits, 0, 1)
+}
@@ -0,0 +1,77 @@
+package checker_test
+
+import (
+	"time"
+)
+
+func bufferedChan(timeout time.Duration) int {
+	ch := make(chan int, 1)
+	go func() {
+		ch <- computeValue()
+	}()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(timeout):
+		return -1
+	}
+}
+
+func plainReceive() int {
+	ch := make(chan int)
+	go func() {
+		ch <- computeValue()
+	}()
+	return <-ch
+}
+
+func drainedAfterTimeout(timeout time.Duration) int {
+	ch := make(chan int)
+	go func() {
+		ch <- computeValue()
+	}()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(timeout):
+		go func() { <-ch }()
+		return -1
+	}
+}
+
+func singleCaseSelect() int {
+	ch := make(chan int)
+	go func() {
+		ch <- computeValue()
+	}()
+	select {
+	case v := <-ch:
+		return v
+	}
+}
+
+func closedInsteadOfSent(timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
+
+func fanIn() (int, int) {
+	ch1 := make(chan int)
+	ch2 := make(chan int)
+	go func() { ch1 <- computeValue() }()
+	go func() { ch2 <- computeValue() }()
+	var a, b int
+	for i := 0; i < 2; i++ {
+		select {
+		case a = <-ch1:
+		case b = <-ch2:
+		}
+	}
+	return a, b
+}
@@ -0,0 +1,64 @@
+package checker_test
+
+import (
+	"context"
+	"time"
+)
+
+func computeValue() int { return 0 }
+
+func withTimeout(timeout time.Duration) int {
+	ch := make(chan int)
+	go func() {
+		/*! goroutine blocks forever on ch send if select takes another case; make the channel buffered with size 1 */
+		ch <- computeValue()
+	}()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(timeout):
+		return -1
+	}
+}
+
+func withContext(ctx context.Context) error {
+	errc := make(chan error, 0)
+	go func() {
+		/*! goroutine blocks forever on errc send if select takes another case; make the channel buffered with size 1 */
+		errc <- nil
+	}()
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func withDefault() {
+	done := make(chan struct{})
+	go func() {
+		/*! goroutine blocks forever on done send if select takes another case; make the channel buffered with size 1 */
+		done <- struct{}{}
+	}()
+	select {
+	case <-done:
+	default:
+	}
+}
+
+func withTimer(timeout time.Duration) int {
+	ch := make(chan int)
+	go func() {
+		/*! goroutine blocks forever on ch send if select takes another case; make the channel buffered with size 1 */
+		ch <- computeValue()
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case v := <-ch:
+		return v
+	case <-timer.C:
+		return -1
+	}
+}
@@ -75,3 +75,11 @@ func balancedIncDec(x, y, z int) {
 	_ = x-0 < y-0
 	_ = x-1 < y-1
 }
+
+func shadowedBoolConsts(x bool) {
+	true := x
+	false := !x
+
+	_ = x == true
+	_ = x && false
+}
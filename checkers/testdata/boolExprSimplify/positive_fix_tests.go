@@ -0,0 +1,50 @@
+package checker_test
+
+func deMorgan(n int, ok, done bool, p *int) {
+	/*! can simplify `!(n > 0 && p != nil)` to `n <= 0 || p == nil` */
+	_ = !(n > 0 && p != nil)
+
+	/*! can simplify `!(!ok || n == 1)` to `ok && n != 1` */
+	_ = !(!ok || n == 1)
+
+	/*! can simplify `done && !(n > 0 && p != nil)` to `done && (n <= 0 || p == nil)` */
+	_ = done && !(n > 0 && p != nil)
+
+	_ = !(ok && done)
+}
+
+func compareWithConst(ok, done bool, n int) {
+	/*! can simplify `ok == true` to `ok` */
+	_ = ok == true
+
+	/*! can simplify `false != ok` to `ok` */
+	_ = false != ok
+
+	/*! can simplify `ok == false` to `!ok` */
+	_ = ok == false
+
+	/*! can simplify `(ok && done) != true` to `!(ok && done)` */
+	_ = (ok && done) != true
+
+	/*! can simplify `n > 1 == false` to `n <= 1` */
+	_ = n > 1 == false
+
+	/*! can simplify `ok == done == true` to `ok == done` */
+	_ = ok == done == true
+}
+
+func foldConstOperand(ok bool, f func() bool) {
+	/*! can simplify `ok && true` to `ok` */
+	_ = ok && true
+
+	/*! can simplify `false || f()` to `f()` */
+	_ = false || f()
+
+	/*! can simplify `ok || true` to `true` */
+	_ = ok || true
+
+	/*! can simplify `false && f()` to `false` */
+	_ = false && f()
+
+	_ = f() || true
+}
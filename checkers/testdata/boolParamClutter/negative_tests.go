@@ -0,0 +1,17 @@
+package checker_test
+
+import (
+	"io"
+)
+
+type verbose bool
+
+type force bool
+
+func singleBool(w io.Writer, pretty bool) {}
+
+func definedFlags(v verbose, f force) {}
+
+func mixedFlags(v verbose, dryRun bool) {}
+
+func noBools(a, b int) {}
@@ -0,0 +1,16 @@
+package checker_test
+
+import (
+	"io"
+)
+
+/*! render has 2 bool params; consider an options struct or defined flag types */
+func render(w io.Writer, pretty, escape bool) {}
+
+/*! copyFiles has 3 bool params; consider an options struct or defined flag types */
+func copyFiles(src string, recursive bool, dst string, force bool, dryRun bool) {}
+
+type server struct{}
+
+/*! start has 2 bool params; consider an options struct or defined flag types */
+func (s *server) start(bool, bool) {}
@@ -196,3 +196,31 @@ func assigningToBuiltinIdentifiers() {
 	recover := 1
 	_ = recover
 }
+
+func shadowNewBuiltins(xs []int) {
+	/*! shadowing of predeclared identifier: min */
+	min := xs[0]
+	/*! shadowing of predeclared identifier: max */
+	/*! shadowing of predeclared identifier: clear */
+	max, clear := xs[1], true
+	_, _, _ = min, max, clear
+}
+
+func shadowUsedBuiltin(xs []int) int {
+	n := len(xs)
+	if n > 0 {
+		/*! shadowing of predeclared identifier: len, which is used as a builtin later in this function */
+		len := xs[0]
+		return len
+	}
+	return n + len(xs)
+}
+
+func shadowInLoop(xs []int) *int {
+	for i := range xs {
+		/*! shadowing of predeclared identifier: new, which is used as a builtin later in this function */
+		new := i * 2
+		_ = new
+	}
+	return new(int)
+}
@@ -26,3 +26,6 @@ const (
 	/*! shadowing of predeclared identifier: complex128 */
 	complex128 = 2
 )
+
+/*! shadowing of predeclared identifier: min */
+func min(a, b int) int { return a }
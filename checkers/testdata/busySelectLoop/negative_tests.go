@@ -0,0 +1,81 @@
+package checker_test
+
+import (
+	"runtime"
+	"time"
+)
+
+func sleepInDefault(ch chan int) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func yieldInDefault(ch chan int) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		default:
+			runtime.Gosched()
+		}
+	}
+}
+
+func noDefault(ch chan int) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		}
+	}
+}
+
+func exitInDefault(ch chan int) {
+loop:
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		default:
+			break loop
+		}
+	}
+}
+
+func returnInDefault(ch chan int) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		default:
+			return
+		}
+	}
+}
+
+func pollingLoop(ch chan int) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		default:
+		}
+		handle(0)
+	}
+}
+
+func conditionalLoop(ch chan int, n int) {
+	for i := 0; i < n; i++ {
+		select {
+		case v := <-ch:
+			handle(v)
+		default:
+		}
+	}
+}
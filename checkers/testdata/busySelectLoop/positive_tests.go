@@ -0,0 +1,31 @@
+package checker_test
+
+func handle(int) {}
+
+func emptyDefault(ch chan int) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		/*! default case makes the loop spin the CPU; remove it or add a wait mechanism */
+		default:
+		}
+	}
+}
+
+func counterDefault(ch chan int, done chan struct{}) {
+	misses := 0
+	for {
+		select {
+		case <-done:
+			return
+		/*! default case makes the loop spin the CPU; remove it or add a wait mechanism */
+		default:
+			misses++
+			if misses > 10 {
+				break
+			}
+			continue
+		}
+	}
+}
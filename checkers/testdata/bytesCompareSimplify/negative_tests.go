@@ -0,0 +1,26 @@
+package checker_test
+
+import (
+	"bytes"
+	"strings"
+)
+
+func compareOK(a, b []byte, s1, s2 string) {
+	_ = bytes.Equal(a, b)
+	_ = bytes.Compare(a, b) < 0
+	_ = bytes.Compare(a, b) == 1
+	_ = strings.Compare(s1, s2) == -1
+	_ = s1 < s2
+
+	switch bytes.Compare(a, b) {
+	case 0:
+	}
+}
+
+type comparer struct{}
+
+func (comparer) Compare(a, b string) int { return 0 }
+
+func customCompare(c comparer, s1, s2 string) {
+	_ = c.Compare(s1, s2) == 0
+}
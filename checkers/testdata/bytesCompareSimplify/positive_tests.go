@@ -0,0 +1,32 @@
+package checker_test
+
+import (
+	"bytes"
+	"strings"
+)
+
+func bytesCompare(a, b []byte) {
+	/*! bytes.Compare(a, b) == 0 can be simplified to bytes.Equal(a, b) */
+	_ = bytes.Compare(a, b) == 0
+
+	/*! bytes.Compare(a, b[1:]) != 0 can be simplified to !bytes.Equal(a, b[1:]) */
+	if bytes.Compare(a, b[1:]) != 0 {
+	}
+
+	/*! 0 == bytes.Compare(a, b) can be simplified to bytes.Equal(a, b) */
+	_ = 0 == bytes.Compare(a, b)
+}
+
+func stringsCompare(s1, s2 string) {
+	/*! strings.Compare(s1, s2) == 0 can be simplified to s1 == s2 */
+	_ = strings.Compare(s1, s2) == 0
+
+	/*! strings.Compare(s1, s2) < 0 can be simplified to s1 < s2 */
+	_ = strings.Compare(s1, s2) < 0
+
+	/*! strings.Compare(s1+"x", s2) >= 0 can be simplified to s1+"x" >= s2 */
+	_ = strings.Compare(s1+"x", s2) >= 0
+
+	/*! 0 < strings.Compare(s1, s2) can be simplified to s1 > s2 */
+	_ = 0 < strings.Compare(s1, s2)
+}
@@ -40,3 +40,14 @@ func noWarnings() {
 		)
 	}
 }
+
+func allowedNames(ID int) (DB string) {
+	ID, DB = 1, "db"
+	return DB
+}
+
+func allowedLocals() {
+	ID := 1
+	var DB = "db"
+	_, _ = ID, DB
+}
@@ -53,3 +53,12 @@ func localBody() {
 		)
 	}
 }
+
+func localsWithAllowedNames() {
+	/*! `URL' should not be capitalized */
+	ID, URL := 1, "url"
+
+	/*! `DSN' should not be capitalized */
+	var DB, DSN = "db", "dsn"
+	_, _, _, _ = ID, URL, DB, DSN
+}
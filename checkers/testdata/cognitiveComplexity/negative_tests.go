@@ -0,0 +1,25 @@
+package checker_test
+
+func simple(xs []int) int {
+	n := 0
+	for _, x := range xs { // +1
+		if x > 0 && x < 10 { // +2 +1
+			n++
+		}
+	}
+	return n
+}
+
+func flat(a, b bool) int {
+	if a { // +1
+		return 1
+	}
+	if b { // +1
+		return 2
+	}
+	switch { // +1
+	case a && b: // +1
+		return 3
+	}
+	return 0
+}
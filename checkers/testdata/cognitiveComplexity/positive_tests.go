@@ -0,0 +1,55 @@
+package checker_test
+
+/*! cognitive complexity of nestedLoops is 6, max is 5 */
+func nestedLoops(xs []int) int {
+	n := 0
+	for _, x := range xs { // +1
+		if x > 0 { // +2
+			if x%2 == 0 { // +3
+				n++
+			}
+		}
+	}
+	return n
+}
+
+/*! cognitive complexity of branches is 6, max is 5 */
+func branches(a, b, c bool) string {
+	if a && b && c { // +1 +1
+		return "all"
+	} else if a || b && c { // +1 +2
+		return "some"
+	} else { // +1
+		return "none"
+	}
+}
+
+/*! cognitive complexity of closures is 6, max is 5 */
+func closures(xs []int) func() int {
+	return func() int {
+		switch len(xs) { // +2
+		case 0:
+			return 0
+		default:
+			for range xs { // +3
+				break
+			}
+		}
+		return closures(xs[1:])() // +1
+	}
+}
+
+/*! cognitive complexity of jumps is 11, max is 5 */
+func jumps(xs [][]int) {
+outer:
+	for _, row := range xs { // +1
+		for _, x := range row { // +2
+			select { // +3
+			default:
+				if x == 0 { // +4
+				}
+			}
+			continue outer // +1
+		}
+	}
+}
@@ -46,3 +46,17 @@ func f1() {
 	//
 	// inside it.
 }
+
+//nolint // explanation
+
+//nolint:all
+
+//line foo.go:10
+
+//line :20
+
+//easyjson:json
+type jsonObject struct{}
+
+//kubebuilder:object:root=true
+type crdObject struct{}
@@ -17,3 +17,9 @@ var (
 	// the convention
 	x = 10
 )
+
+/*! put a space between `//` and comment text */
+//lines are not directives
+
+/*! put a space between `//` and comment text */
+//nolintish comment
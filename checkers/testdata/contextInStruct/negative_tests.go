@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"context"
+)
+
+type goodWorker struct {
+	id int
+}
+
+func (w *goodWorker) Do(ctx context.Context) error {
+	return ctx.Err()
+}
+
+type contextFunc struct {
+	newContext func() context.Context
+}
+
+type cancelHolder struct {
+	cancel context.CancelFunc
+}
+
+type contextPtr struct {
+	// Not a context.Context itself.
+	ctx *context.Context
+}
+
+// requestCarrier is listed in the allowTypes param.
+type requestCarrier struct {
+	ctx context.Context
+}
@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"context"
+)
+
+type worker struct {
+	/*! worker.ctx stores a context.Context; pass it as the first function parameter instead */
+	ctx context.Context
+
+	id int
+}
+
+type embeddedContext struct {
+	/*! embeddedContext.Context stores a context.Context; pass it as the first function parameter instead */
+	context.Context
+}
+
+type multipleContexts struct {
+	/*! multipleContexts.parent stores a context.Context; pass it as the first function parameter instead */
+	/*! multipleContexts.child stores a context.Context; pass it as the first function parameter instead */
+	parent, child context.Context
+}
+
+func localStruct() {
+	type job struct {
+		/*! job.ctx stores a context.Context; pass it as the first function parameter instead */
+		ctx context.Context
+	}
+	_ = job{}
+}
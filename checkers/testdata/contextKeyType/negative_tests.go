@@ -0,0 +1,19 @@
+package checker_test
+
+import (
+	"context"
+)
+
+type ctxKey struct{}
+
+type ctxStringKey string
+
+const requestIDKey ctxStringKey = "requestID"
+
+func definedTypeKeys(ctx context.Context, key interface{}) {
+	_ = context.WithValue(ctx, ctxKey{}, 1)
+	_ = context.WithValue(ctx, requestIDKey, 1)
+	_ = context.WithValue(ctx, ctxStringKey("x"), 1)
+	_ = context.WithValue(ctx, &ctxKey{}, 1)
+	_ = context.WithValue(ctx, key, 1)
+}
@@ -0,0 +1,24 @@
+package checker_test
+
+import (
+	"context"
+)
+
+const userKeyName = "user"
+
+func basicTypeKeys(ctx context.Context, k string, id int) {
+	/*! "user" key of built-in type string may collide with other packages; define an unexported key type */
+	_ = context.WithValue(ctx, "user", 1)
+
+	/*! userKeyName key of built-in type string may collide with other packages; define an unexported key type */
+	_ = context.WithValue(ctx, userKeyName, 1)
+
+	/*! k key of built-in type string may collide with other packages; define an unexported key type */
+	_ = context.WithValue(ctx, k, 1)
+
+	/*! 10 key of built-in type int may collide with other packages; define an unexported key type */
+	_ = context.WithValue(ctx, 10, 1)
+
+	/*! id key of built-in type int may collide with other packages; define an unexported key type */
+	_ = context.WithValue(ctx, id, 1)
+}
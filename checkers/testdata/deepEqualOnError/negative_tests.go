@@ -0,0 +1,20 @@
+package checker_test
+
+import (
+	"reflect"
+)
+
+type point struct{ x, y int }
+
+type tree struct {
+	value    int
+	children []*tree
+}
+
+func compareOK(a, b point, t1, t2 *tree, xs []string, v interface{}, err error) {
+	_ = reflect.DeepEqual(a, b)
+	_ = reflect.DeepEqual(t1, t2)
+	_ = reflect.DeepEqual(xs, []string{"a"})
+	_ = reflect.DeepEqual(v, 1)
+	_ = reflect.DeepEqual(err, nil)
+}
@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"time"
+)
+
+type myError struct{ msg string }
+
+func (e *myError) Error() string { return e.msg }
+
+type event struct {
+	name string
+	at   time.Time
+}
+
+type handler struct {
+	name string
+	fn   func()
+}
+
+func compareErrors(err error, perr *os.PathError, merr *myError) {
+	/*! reflect.DeepEqual on errors compares their internal state and ignores wrapping; use errors.Is */
+	_ = reflect.DeepEqual(err, io.EOF)
+	/*! reflect.DeepEqual on errors compares their internal state and ignores wrapping; use errors.Is */
+	_ = reflect.DeepEqual(perr, &os.PathError{})
+	/*! reflect.DeepEqual on errors compares their internal state and ignores wrapping; use errors.Is */
+	_ = reflect.DeepEqual(merr, merr)
+}
+
+func compareParts(a, b event, hs []handler, times map[string]time.Time) {
+	/*! a contains time.Time values that should be compared with Equal method */
+	_ = reflect.DeepEqual(a, b)
+	/*! hs contains func values that are never deeply equal unless nil; compare fields explicitly */
+	_ = reflect.DeepEqual(hs, hs[:1])
+	/*! times contains time.Time values that should be compared with Equal method */
+	_ = reflect.DeepEqual(times, map[string]time.Time{})
+}
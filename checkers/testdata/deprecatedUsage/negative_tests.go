@@ -0,0 +1,18 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+)
+
+// Deprecated: use newHelper instead.
+func oldHelper() {}
+
+func localDeprecatedIsFine() {
+	oldHelper()
+}
+
+func modernAPI(r io.Reader, f *os.File) ([]byte, error) {
+	f.Seek(0, io.SeekStart)
+	return io.ReadAll(r)
+}
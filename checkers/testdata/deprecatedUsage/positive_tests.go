@@ -0,0 +1,29 @@
+package checker_test
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+)
+
+func readAll(r io.Reader) ([]byte, error) {
+	/*! ioutil.ReadAll is deprecated: As of Go 1.16, this function simply calls [io.ReadAll]. */
+	return ioutil.ReadAll(r)
+}
+
+/*! reflect.SliceHeader is deprecated: Use unsafe.Slice or unsafe.SliceData instead. */
+var header reflect.SliceHeader
+
+func seekStart(f *os.File) {
+	/*! os.SEEK_SET is deprecated: Use io.SeekStart, io.SeekCurrent, and io.SeekEnd. */
+	f.Seek(0, os.SEEK_SET)
+}
+
+func tlsConfig() *tls.Config {
+	cfg := &tls.Config{}
+	/*! cfg.PreferServerCipherSuites is deprecated: PreferServerCipherSuites is ignored. */
+	cfg.PreferServerCipherSuites = true
+	return cfg
+}
@@ -1,5 +1,11 @@
 package checker_test
 
+import (
+	"bytes"
+	"math/rand"
+	"time"
+)
+
 func floatBinOps() {
 	var x float64
 
@@ -38,3 +44,23 @@ func uncheckedOps(x int) {
 	_ = x + x
 	_ = x * x
 }
+
+func impureCalls(ch chan int, b *bytes.Buffer) {
+	if rand.Int() == rand.Int() {
+	}
+	if time.Now().Unix() != time.Now().Unix() {
+	}
+	if <-ch < <-ch {
+	}
+	if b.Len() > b.Len() {
+	}
+	if next() == next() {
+	}
+}
+
+var counter int
+
+func next() int {
+	counter++
+	return counter
+}
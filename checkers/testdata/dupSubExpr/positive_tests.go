@@ -1,5 +1,10 @@
 package checker_test
 
+import (
+	"math/bits"
+	"strings"
+)
+
 type point struct{ x, y int }
 
 func lhsRhsDuplicates() {
@@ -32,3 +37,20 @@ func lhsRhsDuplicates() {
 	if (1+p.x+3) >= (1+p.x+3) && p.y^p.y != 0 {
 	}
 }
+
+func pureCalls(xs []int, s string, x uint) {
+	/*! suspicious identical LHS and RHS for `==` operator */
+	if len(xs) == len(xs) {
+	}
+
+	/*! suspicious identical LHS and RHS for `!=` operator */
+	if strings.ToLower(s) != strings.ToLower(s) {
+	}
+
+	/*! suspicious identical LHS and RHS for `<` operator */
+	if bits.OnesCount(x) < bits.OnesCount(x) {
+	}
+
+	/*! suspicious identical LHS and RHS for `-` operator */
+	_ = int(x) - int(x)
+}
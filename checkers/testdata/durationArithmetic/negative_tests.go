@@ -0,0 +1,28 @@
+package checker_test
+
+import (
+	"time"
+)
+
+const retries = 3
+
+func goodDurations(n int, d, backoff time.Duration, seconds int64) {
+	_ = time.Duration(n) * time.Second
+	_ = time.Duration(seconds) * time.Second
+	_ = d * 2
+	_ = d * retries
+	_ = time.Duration(n) * backoff
+	_ = 5 * time.Second
+	_ = time.Hour * 24
+	_ = d * d
+
+	if d > 0 {
+	}
+	if d != 0 {
+	}
+	if d > 5*time.Second {
+	}
+	if d > backoff {
+	}
+	_ = n > 5
+}
@@ -0,0 +1,29 @@
+package checker_test
+
+import (
+	"time"
+)
+
+type config struct {
+	Timeout time.Duration
+}
+
+func scaledTwice(cfg config, d time.Duration) {
+	/*! cfg.Timeout is already a time.Duration; multiplying it by time.Second scales it twice */
+	_ = cfg.Timeout * time.Second
+	/*! d is already a time.Duration; multiplying it by 2 * time.Millisecond scales it twice */
+	_ = (2 * time.Millisecond) * d
+}
+
+func convertedProduct(n, m int) {
+	/*! product of two durations time.Duration(n) and time.Duration(m) has no meaningful unit */
+	_ = time.Duration(n) * time.Duration(m)
+}
+
+func bareInt(elapsed time.Duration) {
+	/*! elapsed is compared with 5 nanoseconds; use an explicit unit like 5*time.Second */
+	if elapsed > 5 {
+	}
+	/*! elapsed is compared with 30 nanoseconds; use an explicit unit like 30*time.Second */
+	_ = 30 <= elapsed
+}
@@ -0,0 +1,33 @@
+package checker_test
+
+import (
+	"net"
+)
+
+type override struct {
+	base
+
+	// Same type is a deliberate override.
+	Name string
+}
+
+func (o *override) Reset() {}
+
+func (o override) Describe() string { return "override" }
+
+type closer struct {
+	net.Conn
+	closed bool
+}
+
+func (c *closer) Close() error {
+	c.closed = true
+	return c.Conn.Close()
+}
+
+type plain struct {
+	ID   string
+	Name int
+}
+
+func (p plain) Describe(verbose bool) {}
@@ -0,0 +1,42 @@
+package checker_test
+
+import (
+	"bytes"
+	"net"
+)
+
+type base struct {
+	ID   int
+	Name string
+}
+
+func (b *base) Reset() {}
+
+func (b base) Describe() string { return b.Name }
+
+type derived struct {
+	base
+
+	/*! field ID shadows promoted base.ID of a different type */
+	ID string
+
+	/*! field Reset shadows promoted base.Reset of a different type */
+	Reset bool
+}
+
+/*! method Describe shadows promoted base.Describe of a different type */
+func (d *derived) Describe(verbose bool) string { return "" }
+
+type conn struct {
+	net.Conn
+
+	/*! field Close shadows promoted Conn.Close of a different type */
+	Close bool
+}
+
+type buffer struct {
+	*bytes.Buffer
+}
+
+/*! method Len shadows promoted Buffer.Len of a different type */
+func (b buffer) Len() int64 { return 0 }
@@ -0,0 +1,24 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+func wrappingVerbs(err error, name string, format string) {
+	_ = fmt.Errorf("read config: %w", err)
+	_ = fmt.Errorf("read %s: %w", name, err)
+
+	// Explicit opt-out: the error text is formatted, not the error.
+	_ = fmt.Errorf("read config: %v", err.Error())
+
+	// Not a constant format.
+	_ = fmt.Errorf(format, err)
+
+	// Explicit argument indexes are not handled.
+	_ = fmt.Errorf("%[1]v", err)
+
+	// Non-error arguments.
+	_ = fmt.Errorf("%v %s", name, name)
+
+	_ = fmt.Sprintf("%v", err)
+}
@@ -0,0 +1,26 @@
+package checker_test
+
+import (
+	"fmt"
+	"os"
+)
+
+func nonWrappingVerbs(err error, pathErr *os.PathError, name string) {
+	/*! use %w instead of %v to wrap err error */
+	_ = fmt.Errorf("read config: %v", err)
+
+	/*! use %w instead of %s to wrap err error */
+	_ = fmt.Errorf("read %s: %s", name, err)
+
+	/*! use %w instead of %v to wrap pathErr error */
+	_ = fmt.Errorf("%d%% done: %+v", 50, pathErr)
+
+	const format = "open: %v"
+	/*! use %w instead of %v to wrap err error */
+	_ = fmt.Errorf(format, err)
+}
+
+func multipleWrapVerbs(err1, err2 error) {
+	/*! multiple %w verbs are not supported before Go 1.20 (current version is go1.19) */
+	_ = fmt.Errorf("%w: %w", err1, err2)
+}
@@ -41,3 +41,12 @@ func justDefer() {
 func noDefers() {
 	println("")
 }
+
+type otherLogger struct{}
+
+func (otherLogger) Fatal(args ...interface{}) {}
+
+func notExitFunc(l otherLogger) {
+	defer println("")
+	l.Fatal("not an exit")
+}
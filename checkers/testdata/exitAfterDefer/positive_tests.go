@@ -48,3 +48,21 @@ func deferLambda() {
 	/*! os.Exit clutters `defer func(x int){...}(...)` */
 	os.Exit(0)
 }
+
+func fatalf(format string, args ...interface{}) { os.Exit(1) }
+
+type logger struct{}
+
+func (*logger) Fatal(args ...interface{}) { os.Exit(1) }
+
+func customExitFuncs(l *logger) {
+	defer println("")
+	/*! fatalf clutters `defer println("")` */
+	fatalf("failed")
+}
+
+func customExitMethod(l *logger) {
+	defer println("")
+	/*! l.Fatal clutters `defer println("")` */
+	l.Fatal("failed")
+}
@@ -0,0 +1,24 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+)
+
+func reportError(logger *log.Logger, err error) error {
+	log.Print(err)
+	logger.Printf("error: %v", err)
+	return err
+}
+
+func getExitCode() int {
+	return len(os.Args)
+}
+
+type exiter struct{}
+
+func (exiter) Exit(code int) {}
+
+func customExit(e exiter) {
+	e.Exit(1)
+}
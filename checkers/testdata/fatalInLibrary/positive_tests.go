@@ -0,0 +1,34 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+)
+
+func loadConfig(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		/*! log.Fatal in a library package terminates the program; return an error instead */
+		log.Fatal(err)
+	}
+	return data
+}
+
+func mustConnect(addr string) {
+	if addr == "" {
+		/*! log.Fatalf in a library package terminates the program; return an error instead */
+		log.Fatalf("empty address")
+	}
+	/*! log.Fatalln in a library package terminates the program; return an error instead */
+	log.Fatalln("unreachable")
+}
+
+func terminate(code int) {
+	/*! os.Exit in a library package terminates the program; return an error instead */
+	os.Exit(code)
+}
+
+func withLogger(logger *log.Logger) {
+	/*! logger.Fatal in a library package terminates the program; return an error instead */
+	logger.Fatal("failed")
+}
@@ -0,0 +1,25 @@
+package checker_test
+
+type sorted struct {
+	id    int64
+	flag  bool
+	valid bool
+}
+
+type smallWaste struct {
+	a bool
+	n int32
+	b bool
+}
+
+type single struct {
+	v int64
+}
+
+type empty struct{}
+
+type zeroSized struct {
+	_ [0]func()
+	a int64
+	b int32
+}
@@ -0,0 +1,19 @@
+package checker_test
+
+/*! struct of size 24 could be 16 with fields reordered by alignment */
+type commented struct {
+	// first flag.
+	first bool
+	value float64
+	last  bool // trailing comment
+}
+
+/*! struct of size 24 could be 16 with fields reordered by alignment */
+type grouped struct {
+	a, b bool
+	v    uint64
+	c    bool
+}
+
+/*! struct of size 24 could be 16 with fields reordered by alignment */
+type inline struct { x bool; y int; z bool }
@@ -0,0 +1,34 @@
+package checker_test
+
+/*! struct of size 24 could be 16 with fields reordered by alignment */
+type record struct {
+	flag  bool
+	id    int64
+	valid bool
+}
+
+/*! struct of size 40 could be 24 with fields reordered by alignment */
+type entry struct {
+	a    byte
+	name string
+	b    byte
+	n    int32
+	ok   bool
+}
+
+/*! struct of size 40 could be 32 with fields reordered by alignment */
+type tagged struct {
+	Enabled bool      `json:"enabled"`
+	Values  []float64 `json:"values"`
+	Count   uint16    `json:"count"`
+}
+
+func localStruct() {
+	/*! struct of size 24 could be 16 with fields reordered by alignment */
+	var v struct {
+		x bool
+		p *int
+		y bool
+	}
+	_ = v
+}
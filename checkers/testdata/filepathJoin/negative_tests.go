@@ -1,7 +1,10 @@
 package checker_test
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 )
 
 func badArgs() {
@@ -14,3 +17,13 @@ func badArgs() {
 
 	_ = filepath.Join(`testdata`, `a`, `b.txt`)
 }
+
+func manualJoinOK(dir, name, key string, m map[string]string) {
+	os.Open(filepath.Join(dir, name))
+	os.Open(dir + name)
+	os.Open("/etc/" + "passwd")
+	os.Getenv(name + "_DIR")
+	_ = m[dir+"/"+name]
+	_ = fmt.Sprintf("%s/%s", dir, name)
+	_ = strings.ToUpper(dir + "/" + key)
+}
@@ -1,6 +1,11 @@
 package checker_test
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
 	"path/filepath"
 )
 
@@ -18,3 +23,20 @@ func badArgs() {
 	/*! `testdata\` contains a path separator */
 	_ = filepath.Join(`testdata\`, `\a\b.txt`)
 }
+
+func manualJoin(dir, name, host, id string) {
+	/*! use filepath.Join instead of building the path with "/" */
+	os.Open(dir + "/" + name)
+
+	/*! use filepath.Join instead of building the path with "/" */
+	ioutil.ReadFile(dir + "/config/" + name + ".json")
+
+	/*! use filepath.Join instead of building the path with "/" */
+	os.MkdirAll(fmt.Sprintf("%s/%s", dir, name), 0755)
+
+	/*! use path.Join instead of building the path with "/" */
+	http.Get("https://" + host + "/users/" + id)
+
+	/*! use path.Join instead of building the path with "/" */
+	url.Parse(fmt.Sprintf("%v/%v", host, id))
+}
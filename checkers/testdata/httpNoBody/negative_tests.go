@@ -0,0 +1,15 @@
+package checker_test
+
+import (
+	"net/http"
+	"strings"
+)
+
+// This file never uses NewRequestWithContext, so NewRequest is fine.
+func requestsOK(url, method string) {
+	http.NewRequest(http.MethodGet, url, nil)
+	http.NewRequest(http.MethodDelete, url, nil)
+	http.NewRequest(http.MethodPost, url, http.NoBody)
+	http.NewRequest(http.MethodPost, url, strings.NewReader("data"))
+	http.NewRequest(method, url, nil)
+}
@@ -0,0 +1,23 @@
+package checker_test
+
+import (
+	"context"
+	"net/http"
+)
+
+func postWithContext(ctx context.Context, url string) {
+	/*! use http.NoBody instead of nil for requests without payload */
+	http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+
+	/*! use http.NoBody instead of nil for requests without payload */
+	http.NewRequestWithContext(ctx, "PUT", url, nil)
+}
+
+func legacyRequest(url string) {
+	/*! use http.NewRequestWithContext like the rest of this file */
+	http.NewRequest(http.MethodGet, url, nil)
+
+	/*! use http.NewRequestWithContext like the rest of this file */
+	/*! use http.NoBody instead of nil for requests without payload */
+	http.NewRequest(http.MethodPatch, url, nil)
+}
@@ -0,0 +1,105 @@
+package checker_test
+
+type config struct {
+	name, addr, user, password, db string
+	port                           int
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func (cfg config) url() string { return cfg.addr }
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func connect(cfg config) string {
+	return cfg.user + "@" + cfg.url()
+}
+
+func useConfig() {
+	var cfg config
+	_ = connect(cfg)
+	_ = connect(config{name: "x"})
+}
+
+/*! a is heavy (88 bytes); consider passing it by pointer */
+/*! b is heavy (88 bytes); consider passing it by pointer */
+func sameAddr(a, b config) bool {
+	return a.addr == b.addr && a.port == b.port
+}
+
+func useConfigs(cfgs []config) {
+	_ = sameAddr(cfgs[0], cfgs[1])
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func Connect(cfg config) string {
+	return cfg.name
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func modifies(cfg config) string {
+	cfg.port++
+	return cfg.name
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func passesValue(cfg config) string {
+	return connect2(cfg)
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func connect2(cfg config) string {
+	return cfg.name
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func inGoroutine(cfg config) {
+	go func() {
+		_ = cfg.name
+	}()
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func calledWithResult(cfg config) string {
+	return cfg.name
+}
+
+/*! cfg is heavy (88 bytes); consider passing it by pointer */
+func usedAsValue(cfg config) string {
+	return cfg.name
+}
+
+func callers() {
+	_ = calledWithResult(newConfig())
+	f := usedAsValue
+	_ = f
+	defer inGoroutine(config{})
+}
+
+func newConfig() config { return config{} }
+
+type counterBox struct{ n int }
+
+func (b *counterBox) bump() { b.n++ }
+
+type probeBig struct {
+	in  counterBox
+	pad [80]byte
+}
+
+/*! x is heavy (88 bytes); consider passing it by pointer */
+func callsFieldPtrMethod(x probeBig) int {
+	x.in.bump()
+	return x.in.n
+}
+
+/*! x is heavy (88 bytes); consider passing it by pointer */
+func slicesArrayField(x probeBig) byte {
+	s := x.pad[:]
+	s[0] = 1
+	return x.pad[0]
+}
+
+func useProbes() {
+	var v probeBig
+	_ = callsFieldPtrMethod(v)
+	_ = slicesArrayField(v)
+}
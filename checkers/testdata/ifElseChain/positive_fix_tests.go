@@ -0,0 +1,38 @@
+package checker_test
+
+func describeSign(x int) string {
+	/*! rewrite if-else to switch statement */
+	if x == 0 {
+		// Zero is special.
+		return "zero"
+	} else if x < 0 {
+		return "negative" // Below zero.
+	} else {
+		return "positive"
+	}
+}
+
+func withInit(xs []int) {
+	/*! rewrite if-else to switch statement */
+	if n := len(xs); n == 0 {
+	} else if n == 1 {
+		println("one")
+	} else if n < 10 {
+		println("few")
+	}
+}
+
+func withBreak(xs []int) {
+	for _, x := range xs {
+		/*! rewrite if-else to switch statement */
+		if x == 0 {
+			break
+		} else if x == 1 {
+			for {
+				break
+			}
+		} else {
+			continue
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package checker_test
+
+import (
+	"bytes"
+	"crypto/subtle"
+)
+
+const tokenKind = "bearer"
+
+func emptyToken(token string, mac []byte) bool {
+	return token == "" || mac == nil || bytes.Equal(mac, nil)
+}
+
+func tokenType(token, kind string) bool {
+	return kind == tokenKind
+}
+
+func constantTime(token, got []byte) bool {
+	return subtle.ConstantTimeCompare(token, got) == 1
+}
+
+func notSecrets(machine, tokenizer string, tokens int) bool {
+	return machine == tokenizer && tokens == 10
+}
+
+func nonStrings(sig1, sig2 int) bool {
+	return sig1 == sig2
+}
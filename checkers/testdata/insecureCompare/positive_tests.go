@@ -0,0 +1,38 @@
+package checker_test
+
+import (
+	"bytes"
+	"net/http"
+)
+
+type session struct {
+	csrfToken string
+}
+
+func checkToken(r *http.Request, apiToken string) bool {
+	/*! comparison of apiToken is not constant-time; use subtle.ConstantTimeCompare */
+	return r.Header.Get("X-Token") == apiToken
+}
+
+func checkMAC(msg, expectedMAC []byte) bool {
+	/*! comparison of expectedMAC is not constant-time; use subtle.ConstantTimeCompare */
+	return bytes.Equal(msg, expectedMAC)
+}
+
+func checkSession(s *session, got string) bool {
+	/*! comparison of csrfToken is not constant-time; use subtle.ConstantTimeCompare */
+	if s.csrfToken != got {
+		return false
+	}
+	return true
+}
+
+func checkSecret(user_secret string, got []byte) bool {
+	/*! comparison of user_secret is not constant-time; use subtle.ConstantTimeCompare */
+	return bytes.Equal([]byte(user_secret), got)
+}
+
+func checkAPIKey(APIKey, got string) bool {
+	/*! comparison of APIKey is not constant-time; use subtle.ConstantTimeCompare */
+	return got == APIKey
+}
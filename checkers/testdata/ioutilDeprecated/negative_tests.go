@@ -0,0 +1,11 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+)
+
+func modernIO(r io.Reader) {
+	data, _ := io.ReadAll(r)
+	_ = os.WriteFile("x", data, 0644)
+}
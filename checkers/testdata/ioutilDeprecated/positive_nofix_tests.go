@@ -0,0 +1,13 @@
+package checker_test
+
+import (
+	"io/ioutil"
+)
+
+func listDir(dir string) {
+	/*! ioutil.ReadDir is deprecated; use os.ReadDir */
+	infos, _ := ioutil.ReadDir(dir)
+	/*! ioutil.TempFile is deprecated; use os.CreateTemp */
+	f, _ := ioutil.TempFile(dir, "x")
+	_, _ = infos, f
+}
@@ -0,0 +1,11 @@
+package checker_test
+
+/*! io/ioutil package is deprecated; use io and os packages instead */
+import "io/ioutil"
+import "os"
+
+func removeTemp() {
+	/*! ioutil.TempDir is deprecated; use os.MkdirTemp */
+	dir, _ := ioutil.TempDir("", "x")
+	os.RemoveAll(dir)
+}
@@ -0,0 +1,27 @@
+package checker_test
+
+import (
+	"fmt"
+	/*! io/ioutil package is deprecated; use io and os packages instead */
+	"io/ioutil"
+	"strings"
+)
+
+func copyFile(src, dst string) error {
+	/*! ioutil.ReadFile is deprecated; use os.ReadFile */
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	/*! ioutil.WriteFile is deprecated; use os.WriteFile */
+	return ioutil.WriteFile(dst, data, 0644)
+}
+
+func readAll() {
+	/*! ioutil.ReadAll is deprecated; use io.ReadAll */
+	data, _ := ioutil.ReadAll(strings.NewReader("x"))
+	/*! ioutil.Discard is deprecated; use io.Discard */
+	fmt.Fprint(ioutil.Discard, data)
+	/*! ioutil.TempDir is deprecated; use os.MkdirTemp */
+	_, _ = ioutil.TempDir("", "x")
+}
@@ -0,0 +1,13 @@
+package checker_test
+
+type account struct {
+	ID         int    `json:"id"`
+	CreatedAt  string `json:"created_at" yaml:"created_at"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+	OwnerName  string `json:"owner_name"`
+	Ignored    string `json:"-"`
+	Unnamed    string `json:",omitempty"`
+	Untagged   string
+	OtherTag   string `xml:"otherTag"`
+	OnlyLetter string `json:"x"`
+}
@@ -0,0 +1,21 @@
+package checker_test
+
+type user struct {
+	FirstName string `json:"first_name"`
+
+	/*! json tag name "lastName" doesn't follow snake_case naming */
+	LastName string `json:"lastName"`
+
+	/*! json tag name "Email" doesn't follow snake_case naming */
+	Email string `json:"Email,omitempty"`
+
+	/*! yaml tag name "phone-number" doesn't follow snake_case naming */
+	Phone string `json:"phone" yaml:"phone-number"`
+}
+
+func localTypes() {
+	type response struct {
+		/*! json tag name "statusCode" doesn't follow snake_case naming */
+		StatusCode int `json:"statusCode"`
+	}
+}
@@ -0,0 +1,13 @@
+package checker_test
+
+import (
+	"time"
+)
+
+func Exact(a, b, c, d, e, f int) {}
+
+func ShortList(host string, port int, timeout time.Duration) {}
+
+func unexportedLong(a, b, c, d, e, f, g int) {}
+
+func NoParams() {}
@@ -0,0 +1,18 @@
+package checker_test
+
+import (
+	"time"
+)
+
+/*! NewServer has 7 params, max is 6; consider grouping them into a struct */
+func NewServer(host string, port int, tls bool, timeout, idle time.Duration, name string, maxConns int) {
+}
+
+/*! Combined has 8 params, max is 6; consider grouping them into a struct */
+func Combined(a, b, c, d, e, f, g, h int) {}
+
+type Client struct{}
+
+/*! Request has 7 params, max is 6; consider grouping them into a struct */
+func (c *Client) Request(method, url string, body []byte, retries int, timeout time.Duration, verbose bool, headers ...string) {
+}
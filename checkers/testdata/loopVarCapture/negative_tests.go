@@ -0,0 +1,57 @@
+package checker_test
+
+import (
+	"sort"
+)
+
+func copiedVar(xs []int) {
+	for _, x := range xs {
+		x := x
+		go func() {
+			println(x)
+		}()
+	}
+}
+
+func passedAsArg(xs []int) {
+	for _, x := range xs {
+		go func(x int) {
+			println(x)
+		}(x)
+	}
+}
+
+func synchronousClosure(xss [][]int) {
+	for _, xs := range xss {
+		sort.Slice(xs, func(i, j int) bool { return xs[i] < xs[j] })
+		func() {
+			println(len(xs))
+		}()
+	}
+}
+
+func assignedOutsideLoop(xs []int) {
+	var x int
+	for _, x = range xs {
+		go func() {
+			println(x)
+		}()
+	}
+}
+
+func unrelatedCapture(xs []int, y int) {
+	for range xs {
+		go func() {
+			println(y)
+		}()
+	}
+}
+
+func localClosure(xs []int) {
+	for _, x := range xs {
+		f := func() {
+			println(x)
+		}
+		f()
+	}
+}
@@ -0,0 +1,87 @@
+package checker_test
+
+func goroutineCapture(xs []int) {
+	for _, x := range xs {
+		go func() {
+			/*! loop variable x captured by func literal is shared between iterations before Go 1.22 */
+			println(x)
+		}()
+	}
+}
+
+func deferCapture(files []string) {
+	for i, f := range files {
+		defer func() {
+			/*! loop variable i captured by func literal is shared between iterations before Go 1.22 */
+			/*! loop variable f captured by func literal is shared between iterations before Go 1.22 */
+			println(i, f, i)
+		}()
+	}
+}
+
+func storedCapture(xs []string) []func() {
+	var funcs []func()
+	for _, x := range xs {
+		funcs = append(funcs, func() {
+			/*! loop variable x captured by func literal is shared between iterations before Go 1.22 */
+			println(x)
+		})
+	}
+	return funcs
+}
+
+func forClauseCapture(ch chan func()) {
+	for i := 0; i < 10; i++ {
+		ch <- func() {
+			/*! loop variable i captured by func literal is shared between iterations before Go 1.22 */
+			println(i)
+		}
+	}
+}
+
+type group struct{}
+
+func (g *group) Go(f func() error) {}
+
+func groupCapture(g *group, xs []int) {
+	for _, x := range xs {
+		g.Go(func() error {
+			/*! loop variable x captured by func literal is shared between iterations before Go 1.22 */
+			println(x)
+			return nil
+		})
+	}
+}
+
+func localClosureGoroutine(xs []int) {
+	for _, x := range xs {
+		f := func() {
+			/*! loop variable x captured by func literal is shared between iterations before Go 1.22 */
+			println(x)
+		}
+		go f()
+	}
+}
+
+func storedOutsideLoop(xs []int) func() {
+	var f func()
+	for _, x := range xs {
+		f = func() {
+			/*! loop variable x captured by func literal is shared between iterations before Go 1.22 */
+			println(x)
+		}
+	}
+	return f
+}
+
+func returnedClosure(xs []int) func() {
+	for _, x := range xs {
+		if x > 0 {
+			return func() {
+				/*! loop variable x captured by func literal is shared between iterations before Go 1.22 */
+				println(x)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package checker_test
+
+func partialDelete(m map[string]int) {
+	for k, v := range m {
+		if v == 0 {
+			delete(m, k)
+		}
+	}
+}
+
+func deleteFromOther(m, other map[string]int) {
+	for k := range m {
+		delete(other, k)
+	}
+}
+
+func transformCopy(dst, src map[string]int) {
+	for k, v := range src {
+		dst[k] = v * 2
+	}
+}
+
+func copyDifferentTypes(dst map[string]interface{}, src map[string]int) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+func keysWithValues(m map[string]int) []int {
+	var values []int
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+func keysToOtherSlice(m map[string]int, keys, other []string) {
+	for k := range m {
+		keys = append(other, k)
+	}
+	_ = keys
+}
+
+func sliceLoop(xs []int) {
+	var ys []int
+	for i := range xs {
+		ys = append(ys, i)
+	}
+	_ = ys
+}
+
+func mapFromCall(get func() map[string]int) {
+	for k := range get() {
+		delete(get(), k)
+	}
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+func clearLoop(m map[string]int) {
+	/*! could replace the loop with clear(m) */
+	for k := range m {
+		delete(m, k)
+	}
+}
+
+func copyLoop(dst, src map[string]int) {
+	/*! could replace the loop with maps.Copy(dst, src) */
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+func cloneLoop(src map[int]bool) map[int]bool {
+	dst := make(map[int]bool, len(src))
+	/*! could replace the loop with dst = maps.Clone(src) */
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+func keysLoop(m map[string]int) []string {
+	var keys []string
+	/*! could replace the loop with keys = slices.AppendSeq(keys, maps.Keys(m)) */
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type registry struct {
+	items map[string]int
+}
+
+func (r *registry) reset() {
+	/*! could replace the loop with clear(r.items) */
+	for k := range r.items {
+		delete(r.items, k)
+	}
+}
@@ -0,0 +1,70 @@
+package checker_test
+
+func floatMin(a, b float64) float64 {
+	// NaN handling differs from the min builtin.
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func convertedResult(a int32, b int64) int64 {
+	if int64(a) < b {
+		return int64(a)
+	}
+	return int64(b)
+}
+
+func sideEffects(f func() int, b int) int {
+	if f() < b {
+		return f()
+	}
+	return b
+}
+
+func unrelatedResult(a, b, c int) int {
+	if a < b {
+		return a
+	}
+	return c
+}
+
+func withInit(a, b int) int {
+	if d := a - b; a < b {
+		return d
+	}
+	return b
+}
+
+func equality(a, b int) int {
+	if a == b {
+		return a
+	}
+	return b
+}
+
+func differentTargets(a, b int) (x, y int) {
+	if a < b {
+		x = a
+	} else {
+		y = b
+	}
+	return x, y
+}
+
+func shadowedMin(a, b int) int {
+	min := func(x, y int) int { return x }
+	_ = min
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func multipleStatements(a, b int) int {
+	if a < b {
+		println(a)
+		return a
+	}
+	return b
+}
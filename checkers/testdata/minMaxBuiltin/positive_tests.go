@@ -0,0 +1,55 @@
+package checker_test
+
+func minReturn(a, b int) int {
+	/*! could replace with return min(a, b) */
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxReturn(a, b int) int {
+	/*! could replace with return max(a, b) */
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func maxReturnSwapped(a, b uint) uint {
+	/*! could replace with return max(a, b) */
+	if a <= b {
+		return b
+	}
+	return a
+}
+
+func minStrings(a, b string) string {
+	/*! could replace with return min(a, b) */
+	if a >= b {
+		return b
+	}
+	return a
+}
+
+func minAssign(size, limit int) {
+	var n int
+	/*! could replace with n = min(size, limit) */
+	if size < limit {
+		n = size
+	} else {
+		n = limit
+	}
+	_ = n
+}
+
+type point struct{ x, y int }
+
+func maxAssignField(p *point, x int) {
+	/*! could replace with p.x = max(x, p.x) */
+	if x > p.x {
+		p.x = x
+	} else {
+		p.x = p.x
+	}
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+import (
+	"log"
+	"net/http"
+)
+
+func handlerOK(w http.ResponseWriter, r *http.Request) {
+	data, err := parse(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.Print(err)
+		return
+	}
+	w.Write(data)
+}
+
+func lastStatement(w http.ResponseWriter, r *http.Request) {
+	if _, err := parse(r); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func ifElse(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "bad method", http.StatusMethodNotAllowed)
+	} else {
+		w.Write([]byte("ok"))
+	}
+}
+
+func successStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("created"))
+}
+
+func inLoop(w http.ResponseWriter, items []string) {
+	for _, item := range items {
+		if item == "" {
+			http.Error(w, "empty item", http.StatusBadRequest)
+			break
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package checker_test
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func parse(r *http.Request) ([]byte, error) { return nil, nil }
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	data, err := parse(r)
+	if err != nil {
+		/*! http.Error is not followed by return; the handler keeps writing the response */
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+	w.Write(data)
+}
+
+func statusHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		/*! w.WriteHeader is not followed by return; the handler keeps writing the response */
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+	json.NewEncoder(w).Encode("ok")
+}
+
+func sequentialWrites(w http.ResponseWriter, r *http.Request) {
+	/*! http.Error is not followed by return; the handler keeps writing the response */
+	http.Error(w, "oops", http.StatusInternalServerError)
+	w.Write([]byte("done"))
+}
+
+func closureHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			/*! http.Error is not followed by return; the handler keeps writing the response */
+			http.Error(w, "not supported", http.StatusBadRequest)
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,70 @@
+package checker_test
+
+func explicitReturns() (n int, err error) {
+	if err := save(); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func assignedResult(cond bool) (err error) {
+	if cond {
+		err = save()
+	}
+	return
+}
+
+func noShadows() (x int) {
+	x = 10
+	return
+}
+
+func unnamedResults() error {
+	err := save()
+	if err != nil {
+		err := save()
+		return err
+	}
+	return nil
+}
+
+func closureResults() (err error) {
+	f := func() (err error) {
+		return
+	}
+	return f()
+}
+
+func ifInitShadow() (n int, err error) {
+	if err := save(); err != nil {
+		return 0, err
+	}
+	n = 1
+	return
+}
+
+func shadowWithDefer() (err error) {
+	defer func() {
+		if err != nil {
+			println("cleanup")
+		}
+	}()
+	{
+		var err = save()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func shadowAfterBareReturn(cond bool) (err error) {
+	if cond {
+		return
+	}
+	{
+		err := save()
+		println(err)
+	}
+	return nil
+}
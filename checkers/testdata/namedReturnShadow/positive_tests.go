@@ -0,0 +1,38 @@
+package checker_test
+
+import (
+	"errors"
+)
+
+func save() error { return errors.New("failed") }
+
+func shadowInBlock(cond bool) (err error) {
+	if cond {
+		/*! err shadows the named result; assignments to it are not returned */
+		err := save()
+		_ = err
+	}
+	/*! bare return always returns zero value of err, which is only assigned via a shadowing variable */
+	return
+}
+
+func shadowInRange(xs []int) (total int, err error) {
+	/*! total shadows the named result; assignments to it are not returned */
+	for _, total := range xs {
+		_ = total
+	}
+	err = save()
+	/*! bare return always returns zero value of total, which is only assigned via a shadowing variable */
+	return
+}
+
+func shadowInSwitch(v int) (err error) {
+	switch {
+	case v > 0:
+		/*! err shadows the named result; assignments to it are not returned */
+		err := save()
+		println(err)
+	}
+	/*! bare return always returns zero value of err, which is only assigned via a shadowing variable */
+	return
+}
@@ -75,3 +75,39 @@ func noWarnings4(a int) {
 	a++
 	a++
 }
+
+func ifElseNotTerminating(x int) {
+	if x == 0 {
+		x++
+		x++
+		x++
+		x++
+		x++
+	} else {
+		x--
+	}
+}
+
+func ifElseSmallBody(x int) int {
+	if x == 0 {
+		x++
+	} else {
+		return 0
+	}
+	return x
+}
+
+func elseIfChain(x int) int {
+	if x == 1 {
+		return 1
+	} else if x == 0 {
+		x++
+		x++
+		x++
+		x++
+		x++
+	} else {
+		return 0
+	}
+	return x
+}
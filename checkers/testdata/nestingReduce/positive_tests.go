@@ -13,3 +13,82 @@ func loopWithIf(a []int) {
 		}
 	}
 }
+
+func loopWithFix(a []int, b [][]int) {
+	for _, v := range a {
+		/*! invert if cond, replace body with `continue`, move old body after the statement */
+		if v != 0 {
+			// Comments are moved with the body.
+			println(v)
+			println(v)
+			println(v)
+			println(v)
+			println(v) // Trailing comment.
+		}
+	}
+
+	for i := range b {
+		/*! invert if cond, replace body with `continue`, move old body after the statement */
+		if len(b[i]) > 1 && b[i][0] == 0 {
+			for _, x := range b[i] {
+				println(x)
+			}
+			println(i)
+			println(i)
+			println(i)
+			println(i)
+		}
+	}
+}
+
+func ifElseReturn(x int, ok bool) error {
+	/*! invert if cond, replace body with the else branch, move old body after the statement */
+	if ok {
+		println(x)
+		println(x)
+		println(x)
+		println(x)
+		println(x)
+	} else {
+		// Nothing to do.
+		return nil
+	}
+
+	/*! invert if cond, replace body with the else branch, move old body after the statement */
+	if x == 0 {
+		y := x + 1
+		println(y)
+		println(y)
+		println(y)
+		println(y)
+	} else {
+		panic("unexpected x")
+	}
+	return nil
+}
+
+func ifElseNoFix(xs []int) {
+	for _, x := range xs {
+		/*! invert if cond, replace body with the else branch, move old body after the statement */
+		if x > 0 {
+			x := x * 2
+			println(x)
+			println(x)
+			println(x)
+			println(x)
+		} else {
+			break
+		}
+	}
+
+	for _, x := range xs {
+		/*! invert if cond, replace body with `continue`, move old body after the statement */
+		if y := x * 2; y > 0 {
+			println(y)
+			println(y)
+			println(y)
+			println(y)
+			println(y)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package checker_test
+
+func initialized(words []string) map[string]int {
+	var counts map[string]int
+	counts = make(map[string]int)
+	for _, w := range words {
+		counts[w]++
+	}
+	return counts
+}
+
+func lazyInit(words []string) map[string]int {
+	var counts map[string]int
+	for _, w := range words {
+		if counts == nil {
+			counts = make(map[string]int)
+		}
+		counts[w]++
+	}
+	return counts
+}
+
+func bothBranches(cond bool) map[int]bool {
+	var seen map[int]bool
+	if cond {
+		seen = make(map[int]bool)
+	} else {
+		seen = map[int]bool{}
+	}
+	seen[1] = true
+	return seen
+}
+
+func earlyReturn(cond bool) {
+	var m map[int]int
+	if !cond {
+		return
+	}
+	m = map[int]int{}
+	m[0] = 1
+}
+
+func initByPointer() {
+	var m map[string]int
+	initMap(&m)
+	m["x"] = 1
+}
+
+func initMap(m *map[string]int) {
+	*m = map[string]int{}
+}
+
+func initInClosure() {
+	var m map[string]int
+	setup := func() { m = map[string]int{} }
+	setup()
+	m["x"] = 1
+}
+
+func readOnly() int {
+	var m map[string]int
+	return m["x"]
+}
+
+func rangeAssign(maps []map[string]int) {
+	var m map[string]int
+	for _, m = range maps {
+		m["x"] = 1
+	}
+}
+
+func nonNilBranch(m2 map[string]int) {
+	var m map[string]int
+	if len(m2) != 0 {
+		m = m2
+	}
+	if m != nil {
+		m["x"] = 1
+	}
+}
@@ -0,0 +1,38 @@
+package checker_test
+
+func countWords(words []string) map[string]int {
+	var counts map[string]int
+	for _, w := range words {
+		/*! counts may be nil here, writing into a nil map panics; initialize it with make */
+		counts[w]++
+	}
+	return counts
+}
+
+func conditionalInit(cond bool) map[int]bool {
+	var seen map[int]bool
+	if cond {
+		seen = make(map[int]bool)
+	}
+	/*! seen may be nil here, writing into a nil map panics; initialize it with make */
+	seen[1] = true
+	return seen
+}
+
+type index map[string][]int
+
+func inClosure() func() {
+	return func() {
+		var idx index
+		/*! idx may be nil here, writing into a nil map panics; initialize it with make */
+		idx["x"] = append(idx["x"], 1)
+	}
+}
+
+func multiVar() {
+	var a, b map[string]string
+	a = map[string]string{}
+	a["k"] = "v"
+	/*! b may be nil here, writing into a nil map panics; initialize it with make */
+	b["k"] += "v"
+}
@@ -0,0 +1,59 @@
+package checker_test
+
+import (
+	"regexp"
+)
+
+func MustParse(s string) int {
+	if s == "" {
+		panic("empty input")
+	}
+	return len(s)
+}
+
+func AssertPositive(n int) {
+	if n <= 0 {
+		panic("not positive")
+	}
+}
+
+func unexportedHelper() {
+	panic("internal invariant violated")
+}
+
+type config struct{}
+
+func (c *config) Validate() {
+	panic("unexported receiver")
+}
+
+var pattern = func() *regexp.Regexp {
+	re, err := regexp.Compile("a+")
+	if err != nil {
+		panic(err)
+	}
+	return re
+}()
+
+func init() {
+	if pattern == nil {
+		panic("init-time validation")
+	}
+}
+
+func Guarded(f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			cleanupState()
+			panic(r)
+		}
+	}()
+	f()
+}
+
+func cleanupState() {}
+
+func ShadowedPanic() {
+	panic := func(string) {}
+	panic("not a builtin")
+}
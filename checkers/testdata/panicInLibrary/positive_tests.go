@@ -0,0 +1,34 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+func Parse(s string) int {
+	if s == "" {
+		/*! panic in exported function Parse; return an error instead */
+		panic("empty input")
+	}
+	return len(s)
+}
+
+type Config struct{}
+
+func (c *Config) Validate(n int) {
+	if n < 0 {
+		/*! panic in exported function Validate; return an error instead */
+		panic(fmt.Sprintf("negative value %d", n))
+	}
+}
+
+func Each(xs []int, f func(int)) {
+	for _, x := range xs {
+		func() {
+			if x == 0 {
+				/*! panic in exported function Each; return an error instead */
+				panic("zero")
+			}
+			f(x)
+		}()
+	}
+}
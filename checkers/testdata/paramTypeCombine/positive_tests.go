@@ -44,3 +44,9 @@ func withBlank1(_, _ int, _ int, _ int32) {}
 
 /*! func() (_, _ int, _ int, _ int32) could be replaced with func() (_, _, _ int, _ int32) */
 func withBlank2() (_, _ int, _ int, _ int32) { return }
+
+/*! func(a int, b int, c string) could be replaced with func(a, b int, c string) */
+func withComments(a int, b int /* second */, c string) {}
+
+/*! func(a int, b int) (x int, y int) could be replaced with func(a, b int) (x, y int) */
+func withComments2(a /* first */ int, b int) (x int, y int) { return 0, 0 }
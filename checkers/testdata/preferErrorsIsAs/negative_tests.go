@@ -0,0 +1,47 @@
+package checker_test
+
+import (
+	"io"
+	"net"
+)
+
+func nilComparisons(err error) {
+	if err == nil {
+	}
+	if nil != err {
+	}
+}
+
+func localErrorComparison(err error) {
+	other := io.EOF
+	if err == other {
+	}
+}
+
+func typeSwitchOnError(err error) {
+	switch err.(type) {
+	case *customError:
+	}
+}
+
+func interfaceAssertion(err error) {
+	if e, ok := err.(net.Error); ok && e.Timeout() {
+	}
+	if _, ok := err.(interface{ Temporary() bool }); ok {
+	}
+}
+
+func nonErrorValues(x interface{}, s string) {
+	_ = x.(string)
+	_ = s == "EOF"
+}
+
+type wrappedError struct {
+	err error
+}
+
+func (e *wrappedError) Error() string { return e.err.Error() }
+
+func (e *wrappedError) Is(target error) bool {
+	return e.err == io.EOF
+}
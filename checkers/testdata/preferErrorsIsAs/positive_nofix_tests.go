@@ -0,0 +1,10 @@
+package checker_test
+
+import (
+	"io"
+)
+
+func comparisonWithoutErrorsImport(err error) bool {
+	/*! use errors.Is(err, io.ErrUnexpectedEOF) instead of err == io.ErrUnexpectedEOF to handle wrapped errors */
+	return err == io.ErrUnexpectedEOF
+}
@@ -0,0 +1,36 @@
+package checker_test
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+var errNotFound = errors.New("not found")
+
+func sentinelComparisons(err error) {
+	/*! use errors.Is(err, io.EOF) instead of err == io.EOF to handle wrapped errors */
+	if err == io.EOF {
+	}
+
+	/*! use !errors.Is(err, errNotFound) instead of err != errNotFound to handle wrapped errors */
+	if err != errNotFound {
+	}
+
+	/*! use errors.Is(err, os.ErrNotExist) instead of os.ErrNotExist == err to handle wrapped errors */
+	_ = os.ErrNotExist == err
+}
+
+func errorTypeAssertions(err error) {
+	/*! use errors.As instead of err.(*os.PathError) to handle wrapped errors */
+	if e, ok := err.(*os.PathError); ok {
+		_ = e
+	}
+
+	/*! use errors.As instead of err.(*customError) to handle wrapped errors */
+	_ = err.(*customError)
+}
+
+type customError struct{}
+
+func (*customError) Error() string { return "" }
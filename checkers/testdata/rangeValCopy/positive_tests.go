@@ -29,3 +29,75 @@ func bigCopy(xs []bigObject) int32 {
 	}
 	return v
 }
+
+func (o bigObject) sum() int32 { return o.x + o.y }
+
+func (o *bigObject) reset() { o.x = 0 }
+
+func withKey(xs [4]bigObject, i int) int32 {
+	v := int32(0)
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for j, x := range xs {
+		v += x.sum() + int32(j)
+	}
+	return v
+}
+
+func keyTaken(xs *[4]bigObject) int32 {
+	v := int32(0)
+	for i := 0; i < 1; i++ {
+		/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+		for _, x := range xs {
+			v += x.y + int32(i)
+		}
+	}
+	return v
+}
+
+func modifiedValue(xs []bigObject) {
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		x.x++
+		_ = x
+	}
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		x.reset()
+	}
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		consume(x)
+	}
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		xs[0].x = x.x
+	}
+	m := map[int]bigObject{}
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range m {
+		_ = x.x
+	}
+}
+
+func consume(bigObject) {}
+
+type counterField struct{ n int }
+
+func (f *counterField) bump() { f.n++ }
+
+type bigCounted struct {
+	body [1024]byte
+	in   counterField
+}
+
+func writesThroughChain(xs []bigCounted) {
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		x.in.bump()
+	}
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		s := x.body[:]
+		s[0] = 1
+	}
+}
@@ -0,0 +1,43 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+type okID string
+
+func (id okID) String() string {
+	return fmt.Sprintf("user-%s", string(id))
+}
+
+type okPoint struct{ x, y int }
+
+func (p okPoint) String() string {
+	return fmt.Sprintf("(%d, %d) %T %p", p.x, p.y, p, &p)
+}
+
+type counter int
+
+func (c counter) String() string {
+	return fmt.Sprintf("%d", c)
+}
+
+func typeName(x interface{}) string {
+	return fmt.Sprintf("%T", x)
+}
+
+type named int
+
+func (n named) String() string {
+	return typeName(n)
+}
+
+func (n named) Format(x int) string {
+	return fmt.Sprint(n)
+}
+
+type point struct{ x, y int }
+
+func (p *point) String() string {
+	return fmt.Sprint("point", *p)
+}
@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"fmt"
+	"os"
+)
+
+type userID string
+
+func (id userID) String() string {
+	/*! String method formats its receiver, causing infinite recursion */
+	return fmt.Sprintf("user-%s", id)
+}
+
+type ptrPoint struct{ x, y int }
+
+func (p *ptrPoint) String() string {
+	/*! String method formats its receiver, causing infinite recursion */
+	return fmt.Sprint("point", p)
+}
+
+type valuePoint struct{ x, y int }
+
+func (p valuePoint) String() string {
+	/*! String method formats its receiver, causing infinite recursion */
+	return fmt.Sprintf("point %v", &p)
+}
+
+type myErr struct{ code int }
+
+func (e myErr) Error() string {
+	/*! Error method formats its receiver, causing infinite recursion */
+	fmt.Fprintf(os.Stderr, "code %d: %v\n", e.code, e)
+	return "error"
+}
+
+func describe(x interface{}) string {
+	return fmt.Sprintf("<%v>", x)
+}
+
+type wrapped int
+
+func (w wrapped) String() string {
+	/*! String method formats its receiver via describe, causing infinite recursion */
+	return describe(w)
+}
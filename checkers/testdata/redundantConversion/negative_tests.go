@@ -0,0 +1,27 @@
+package checker_test
+
+import (
+	"time"
+)
+
+const timeout = 5
+
+func conversionsOK(n int, id userID, s string, x interface{}) {
+	_ = time.Duration(n) * time.Second
+	_ = time.Duration(timeout)
+	_ = int64(n)
+	_ = string(id)
+	_ = userID(s)
+	_ = []byte(s)
+	_ = float64(1)
+	_ = x.(int)
+}
+
+func genericOK[T ~int](x int) T {
+	return T(x)
+}
+
+func floatRounding(x, y, z float64, c complex128) {
+	_ = float64(x*y) + z
+	_ = complex128(c*c) + c
+}
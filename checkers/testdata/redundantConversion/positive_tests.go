@@ -0,0 +1,34 @@
+package checker_test
+
+import (
+	"time"
+)
+
+type userID string
+
+func redundantConversions(d time.Duration, id userID, n int, b []byte, a, c int) {
+	/*! redundant conversion of d to time.Duration */
+	_ = time.Now().Add(time.Duration(d))
+
+	/*! redundant conversion of id to userID */
+	_ = userID(id)
+
+	/*! redundant conversion of n to int */
+	_ = int(n)
+
+	/*! redundant conversion of b to []byte */
+	_ = append([]byte(b), 'x')
+
+	/*! redundant conversion of a + c to int */
+	_ = int(a+c) * 2
+}
+
+func genericConversion[T any](x T) T {
+	/*! redundant conversion of x to T */
+	return T(x)
+}
+
+func floatVar(x float64) float64 {
+	/*! redundant conversion of x to float64 */
+	return float64(x) * 2
+}
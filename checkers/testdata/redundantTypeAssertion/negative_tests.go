@@ -0,0 +1,21 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+)
+
+func assertOK(r io.Reader, x interface{}) {
+	_ = r.(io.ReadCloser)
+	_ = r.(*os.File)
+	f, ok := r.(*os.File)
+	_, _ = f, ok
+	_, ok = x.(int)
+
+	switch x.(type) {
+	case int:
+	}
+
+	a, b := x, r
+	_, _ = a, b
+}
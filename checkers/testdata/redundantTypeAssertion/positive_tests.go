@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+)
+
+func redundantAssert(r io.Reader, x interface{}) {
+	/*! redundant type assertion: r already has io.Reader type */
+	_ = r.(io.Reader)
+
+	/*! redundant type assertion: x already has interface{} type */
+	if v, ok := x.(interface{}); ok {
+		_ = v
+	}
+}
+
+func discardedOk(r io.Reader, x interface{}) {
+	/*! ok result of r.(*os.File) is discarded; failed assertion silently yields zero value */
+	f, _ := r.(*os.File)
+	_ = f
+
+	var n int
+	/*! ok result of x.(int) is discarded; failed assertion silently yields zero value */
+	n, _ = (x.(int))
+	_ = n
+
+	/*! ok result of x.(string) is discarded; failed assertion silently yields zero value */
+	var s, _ = x.(string)
+	_ = s
+}
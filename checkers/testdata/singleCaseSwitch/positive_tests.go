@@ -1,8 +1,5 @@
 package checker_test
 
-// TODO: add tests for "no warnings" cases.
-// TODO: add tests for expression switch statement.
-
 func intValue(x interface{}) int {
 	/*! should rewrite switch statement to if statement */
 	switch x := x.(type) {
@@ -46,3 +43,75 @@ func badCaseWithBreak(x, y int) {
 		}
 	}
 }
+
+func withInit(f func() int) {
+	/*! should rewrite switch statement to if statement */
+	switch x := f(); x {
+	case 1:
+		println(x)
+	}
+
+	/*! should rewrite switch statement to if statement */
+	switch x := f(); x + 1 {
+	case 1:
+		// Comments are kept.
+		println(x) // And this one too.
+	}
+}
+
+func tagless(x int, ok bool) {
+	/*! should rewrite switch statement to if statement */
+	switch {
+	case x > 0:
+		println(x)
+	}
+
+	/*! should rewrite switch statement to if statement */
+	switch ok {
+	case x > 0 && x < 10:
+		println(x)
+	}
+}
+
+func typeSwitches(v interface{}) {
+	/*! should rewrite switch statement to if statement */
+	switch v.(type) {
+	case error:
+		println("error")
+	}
+
+	/*! should rewrite switch statement to if statement */
+	switch v := v.(type) {
+	case interface{ Name() string }:
+		println(v.Name())
+	}
+}
+
+func typeSwitchNoFix(v interface{}, f func() interface{}) {
+	ok := true
+
+	/*! should rewrite switch statement to if statement */
+	switch v.(type) {
+	case int:
+		println(ok)
+	}
+
+	/*! should rewrite switch statement to if statement */
+	switch x := f(); x.(type) {
+	case int:
+		println(x)
+	}
+
+	/*! should rewrite switch statement to if statement */
+	switch v.(type) {
+	case nil:
+		println("nil")
+	}
+
+	/*! should rewrite switch statement to if statement */
+	switch v.(type) {
+	// Comment outside of case body.
+	case int:
+		println(v)
+	}
+}
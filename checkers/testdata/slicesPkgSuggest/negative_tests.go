@@ -0,0 +1,94 @@
+package checker_test
+
+import (
+	"sort"
+)
+
+type point struct{ x, y int }
+
+func containsByField(xs []point, x int) bool {
+	for _, p := range xs {
+		if p.x == x {
+			return true
+		}
+	}
+	return false
+}
+
+func containsArray(xs [4]int, v int) bool {
+	for _, x := range xs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDependsOnIndex(xs []int) bool {
+	for i, x := range xs {
+		if x == i {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInterface(xs []interface{}, v int) bool {
+	for _, x := range xs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsWithSideEffects(xs []int, next func() int) bool {
+	for _, x := range xs {
+		if x == next() {
+			return true
+		}
+	}
+	return false
+}
+
+func findInverted(xs []int, v int) bool {
+	for _, x := range xs {
+		if x == v {
+			return false
+		}
+	}
+	return true
+}
+
+func equalWithoutLenCheck(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalDifferentIndex(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[len(b)-i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortDescending(xs []int) {
+	sort.Slice(xs, func(i, j int) bool { return xs[i] > xs[j] })
+}
+
+func sortByField(xs []point) {
+	sort.Slice(xs, func(i, j int) bool { return xs[i].x < xs[j].x })
+}
+
+func sortOtherSlice(xs, keys []int) {
+	sort.Slice(xs, func(i, j int) bool { return keys[i] < keys[j] })
+}
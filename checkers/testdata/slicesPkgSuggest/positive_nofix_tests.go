@@ -0,0 +1,11 @@
+package checker_test
+
+func containsWithoutImport(xs []rune, r rune) bool {
+	/*! could replace with return slices.Contains(xs, r) */
+	for _, x := range xs {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
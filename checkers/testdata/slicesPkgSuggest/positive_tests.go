@@ -0,0 +1,75 @@
+package checker_test
+
+import (
+	"slices"
+	"sort"
+)
+
+func containsLoop(xs []string, s string) bool {
+	/*! could replace with return slices.Contains(xs, s) */
+	for _, x := range xs {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIndexLoop(xs []int) bool {
+	/*! could replace with return slices.Contains(xs, 10) */
+	for i := range xs {
+		if 10 == xs[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func indexLoop(xs []int, v int) int {
+	/*! could replace with return slices.Index(xs, v) */
+	for i, x := range xs {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func equalLoop(a, b []byte) bool {
+	/*! could replace with return slices.Equal(a, b) */
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalValueLoop(a, b []string) bool {
+	/*! could replace with return slices.Equal(a, b) */
+	if len(a) != len(b) {
+		return false
+	}
+	for i, x := range a {
+		if x != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortCalls(ints []int, strs []string, floats []float64) {
+	/*! could replace with slices.Sort(ints) */
+	sort.Ints(ints)
+	/*! could replace with slices.Sort(strs) */
+	sort.Strings(strs)
+	/*! could replace with slices.Sort(floats) */
+	sort.Float64s(floats)
+	/*! could replace with slices.Sort(ints) */
+	sort.Slice(ints, func(i, j int) bool { return ints[i] < ints[j] })
+}
+
+var _ = slices.Contains[[]int]
@@ -9,3 +9,14 @@ func g() {
 	_ = len(a) == 0
 	_ = len(a) == 10
 }
+
+func capAndMirroredOK() {
+	a := make([]int, 0, 10)
+
+	_ = cap(a) > 0
+	_ = cap(a) == 0
+	_ = 0 < len(a)
+	_ = 0 == cap(a)
+	_ = len(a) > 1
+	_ = len(a) >= 1
+}
@@ -10,3 +10,44 @@ func f() {
 	/*! len(a) <= 0 can be len(a) == 0 */
 	_ = len(a) <= 0
 }
+
+func capAndMirrored() {
+	a := make([]int, 0, 10)
+
+	/*! cap(a) >= 0 is always true */
+	_ = cap(a) >= 0
+	/*! cap(a) < 0 is always false */
+	_ = cap(a) < 0
+	/*! cap(a) <= 0 can be cap(a) == 0 */
+	_ = cap(a) <= 0
+
+	/*! 0 <= len(a) is always true */
+	_ = 0 <= len(a)
+	/*! 0 > len(a) is always false */
+	_ = 0 > len(a)
+	/*! 0 >= len(a) can be len(a) == 0 */
+	_ = 0 >= len(a)
+}
+
+func negative() {
+	a := []int{}
+	const minusOne = -1
+
+	/*! len(a) == -1 is always false */
+	_ = len(a) == -1
+	/*! len(a) != -1 is always true */
+	_ = len(a) != -1
+	/*! len(a) > -1 is always true */
+	_ = len(a) > -1
+	/*! len(a) <= minusOne is always false */
+	_ = len(a) <= minusOne
+	/*! -1 < cap(a) is always true */
+	_ = -1 < cap(a)
+}
+
+func consistency(a, b []int) {
+	_ = len(a) > 0
+	_ = len(b) > 0
+	/*! len(a) != 0 can be len(a) > 0 for consistency with the rest of the file */
+	_ = len(a) != 0
+}
@@ -0,0 +1,26 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+type userID string
+
+type stringer struct{}
+
+func (stringer) String() string { return "" }
+
+func sprintfOK(id string, n int, uid userID, s stringer, args []interface{}) {
+	_ = fmt.Sprintf("%s", id)
+	_ = fmt.Sprintf("user-%d", n)
+	_ = fmt.Sprintf("user-%s", uid)
+	_ = fmt.Sprintf("user-%s", s)
+	_ = fmt.Sprintf("%5s|", id)
+	_ = fmt.Sprintf("100%% %s", id)
+	_ = fmt.Sprintf("%q", id)
+	_ = fmt.Sprintf("%s %s", args...)
+	_ = fmt.Sprint("user-", id)
+
+	format := "user-%s"
+	_ = fmt.Sprintf(format, id)
+}
@@ -0,0 +1,30 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+func sprintfConcat(id, name, dir string) {
+	/*! could replace with "user-" + id */
+	_ = fmt.Sprintf("user-%s", id)
+
+	/*! could replace with id + name */
+	_ = fmt.Sprintf("%s%s", id, name)
+
+	/*! could replace with dir + "/" + name + ".txt" */
+	_ = fmt.Sprintf("%s/%v.txt", dir, name)
+
+	/*! could replace with "[" + id + "]" */
+	_ = fmt.Sprintf(`[%s]`, id)
+}
+
+func sprintfConcatOperand(id, name string) {
+	/*! could replace with ("user-" + id) */
+	_ = fmt.Sprintf("user-%s", id)[0]
+
+	/*! could replace with (id + "/" + name) */
+	_ = fmt.Sprintf("%s/%s", id, name)[1:]
+
+	/*! could replace with "user-" + id */
+	_ = name + fmt.Sprintf("user-%s", id)
+}
@@ -0,0 +1,37 @@
+package checker_test
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const usersTable = "users"
+
+func findUserOK(db *sql.DB, name string) (*sql.Rows, error) {
+	return db.Query("SELECT * FROM "+usersTable+" WHERE name = $1", name)
+}
+
+func constQueryVar(db *sql.DB, id int) error {
+	query := "DELETE FROM " + usersTable + " WHERE id = ?"
+	_, err := db.Exec(query, id)
+	return err
+}
+
+func queryFromArg(db *sql.DB, query string) error {
+	_, err := db.Exec(query)
+	return err
+}
+
+type shell struct{}
+
+func (shell) Exec(cmd string) error { return nil }
+
+func runShell(s shell, dir string) error {
+	fmt.Println("running in " + dir)
+	return s.Exec("cd " + dir)
+}
+
+func constSprintf(db *sql.DB, id int) error {
+	_, err := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", usersTable), id)
+	return err
+}
@@ -0,0 +1,33 @@
+package checker_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func findUser(db *sql.DB, name string) (*sql.Rows, error) {
+	/*! query passed to Query is built with concatenation; use placeholders and pass values as query args */
+	return db.Query("SELECT * FROM users WHERE name = '" + name + "'")
+}
+
+func deleteUser(ctx context.Context, tx *sql.Tx, id int) error {
+	/*! query passed to ExecContext is built with fmt.Sprintf; use placeholders and pass values as query args */
+	_, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM users WHERE id = %d", id))
+	return err
+}
+
+func countRows(db *sql.DB, table string) int {
+	query := "SELECT count(*) FROM " + table
+	var n int
+	/*! query passed to QueryRow is built with concatenation; use placeholders and pass values as query args */
+	db.QueryRow(query).Scan(&n)
+	return n
+}
+
+func prepareFilter(db *sql.DB, column string) (*sql.Stmt, error) {
+	query := "SELECT id FROM users"
+	query += " WHERE " + column + " = ?"
+	/*! query passed to Prepare is built with concatenation; use placeholders and pass values as query args */
+	return db.Prepare(query)
+}
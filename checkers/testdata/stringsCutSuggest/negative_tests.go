@@ -0,0 +1,71 @@
+package checker_test
+
+import (
+	"strings"
+)
+
+func indexUsedOtherwise(s string) int {
+	i := strings.Index(s, "=")
+	if i == -1 {
+		return 0
+	}
+	_ = s[:i]
+	return i
+}
+
+func indexWithoutSlicing(s string) bool {
+	i := strings.Index(s, "=")
+	return i != -1
+}
+
+func sliceOfOtherString(s, other string) string {
+	i := strings.Index(s, "=")
+	if i == -1 {
+		return ""
+	}
+	return other[:i]
+}
+
+func multiByteSepPlusOne(s string) string {
+	i := strings.Index(s, "==")
+	if i == -1 {
+		return ""
+	}
+	return s[i+1:]
+}
+
+func splitMany(s string) []string {
+	parts := strings.SplitN(s, ":", 3)
+	return parts[:2]
+}
+
+func splitResultEscapes(s string) []string {
+	parts := strings.SplitN(s, ":", 2)
+	return parts
+}
+
+func splitRange(s string) {
+	parts := strings.SplitN(s, ":", 2)
+	for _, p := range parts {
+		_ = p
+	}
+}
+
+func indexReassigned(s string) string {
+	i := strings.Index(s, "=")
+	i = 0
+	return s[:i]
+}
+
+func indexWithoutGuard(s string) string {
+	i := strings.Index(s, "=")
+	return s[:i]
+}
+
+func indexComparedWithZero(s string) string {
+	i := strings.Index(s, "=")
+	if i == 0 {
+		return ""
+	}
+	return s[:i]
+}
@@ -0,0 +1,48 @@
+package checker_test
+
+import (
+	"bytes"
+	"strings"
+)
+
+func indexAndSlice(s string) (key, value string) {
+	/*! use strings.Cut instead of strings.Index(s, "=") and slicing */
+	i := strings.Index(s, "=")
+	if i == -1 {
+		return s, ""
+	}
+	return s[:i], s[i+1:]
+}
+
+func indexAndSliceLen(s, sep string) string {
+	/*! use strings.Cut instead of strings.Index(s, sep) and slicing */
+	i := strings.Index(s, sep)
+	if i < 0 {
+		return ""
+	}
+	return s[i+len(sep):]
+}
+
+func bytesIndexAndSlice(b []byte) []byte {
+	/*! use bytes.Cut instead of bytes.Index(b, []byte(":")) and slicing */
+	i := bytes.Index(b, []byte(":"))
+	if i >= 0 {
+		return b[:i]
+	}
+	return b
+}
+
+func splitInTwo(s string) (string, string) {
+	/*! use strings.Cut instead of strings.SplitN(s, ":", 2) */
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return s, ""
+	}
+	return parts[0], parts[1]
+}
+
+func bytesSplitInTwo(b []byte) []byte {
+	/*! use bytes.Cut instead of bytes.SplitN(b, []byte(":"), 2) */
+	parts := bytes.SplitN(b, []byte(":"), 2)
+	return parts[0]
+}
@@ -0,0 +1,24 @@
+package checker_test
+
+import (
+	"time"
+)
+
+type goodTags struct {
+	A int    `json:"a"`
+	B int    `json:"b,omitempty" xml:"b,attr" yaml:"b,flow"`
+	C string `json:"c,string" db:"c"`
+	D int    `json:"-"`
+	E int    `json:"-,"`
+	F int    `json:",omitempty"`
+	G int    `custom:"anything,goes,here" validate:"required,min=1"`
+	H string `xml:"a>b,omitempty"`
+	I string `yaml:",inline"`
+
+	Created  *time.Time `json:"created,omitempty"`
+	Updated  time.Time  `json:"updated,omitzero"`
+	Escaped  string     `json:"quo\"ted"`
+	Spaced   string     `json:"x"   xml:"y"`
+	Untagged string
+	NoOpts   inner `json:"noOpts"`
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+import (
+	"time"
+)
+
+type badTags struct {
+	/*! json struct tag key has a space after the colon */
+	A int `json: "a"`
+
+	/*! json struct tag key value is not quoted */
+	B int `json:b`
+
+	/*! bad syntax for struct tag `json:"c` */
+	C int `json:"c`
+
+	/*! bad syntax for struct tag `json` */
+	D int `json`
+
+	/*! duplicated json struct tag key */
+	E int `json:"e" json:"ee"`
+
+	/*! unknown json struct tag option "omitmepty" */
+	F int `json:"f,omitmepty"`
+
+	/*! unknown xml struct tag option "attribute" */
+	G int `xml:"g,attribute"`
+
+	/*! unknown yaml struct tag option "omit" */
+	H int `yaml:"h,omit"`
+
+	/*! unknown db struct tag option "pk" */
+	I int `db:"i,pk"`
+
+	/*! omitempty has no effect on fields of struct type time.Time */
+	Created time.Time `json:"created,omitempty"`
+
+	/*! omitempty has no effect on fields of struct type inner */
+	Inner inner `json:",omitempty" yaml:"inner"`
+}
+
+type inner struct {
+	X int
+}
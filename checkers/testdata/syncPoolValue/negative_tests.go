@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"bytes"
+	"sync"
+)
+
+var bufPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+var slicePtrPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 4096)
+		return &buf
+	},
+}
+
+type putter struct{}
+
+func (putter) Put(x interface{}) {}
+
+func putPointers(buf *bytes.Buffer, slice *[]byte, m map[string]int, x interface{}, p putter) {
+	bufPool.Put(buf)
+	slicePtrPool.Put(slice)
+	bufPool.Put(m)
+	bufPool.Put(x)
+	p.Put(42)
+}
@@ -0,0 +1,29 @@
+package checker_test
+
+import (
+	"sync"
+)
+
+type buffer struct {
+	data []byte
+}
+
+var slicePool = sync.Pool{
+	New: func() interface{} {
+		/*! New returns non-pointer value of type []byte; return *[]byte to avoid allocations */
+		return make([]byte, 0, 4096)
+	},
+}
+
+var structPool = &sync.Pool{
+	/*! New returns non-pointer value of type buffer; return *buffer to avoid allocations */
+	New: func() interface{} { return buffer{} },
+}
+
+func putValues(buf []byte, b buffer, pool *sync.Pool) {
+	/*! non-pointer value of type []byte passed to Put causes an allocation; store *[]byte instead */
+	slicePool.Put(buf[:0])
+
+	/*! non-pointer value of type buffer passed to Put causes an allocation; store *buffer instead */
+	pool.Put(b)
+}
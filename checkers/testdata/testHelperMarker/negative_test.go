@@ -0,0 +1,32 @@
+package checker_test
+
+import (
+	"testing"
+)
+
+func assertTrue(t *testing.T, cond bool) {
+	t.Helper()
+	if !cond {
+		t.Fatal("condition is false")
+	}
+}
+
+func logOnly(t *testing.T, msg string) {
+	t.Log(msg)
+}
+
+func runSubtests(t *testing.T) {
+	t.Run("sub", func(t *testing.T) {
+		t.Fatal("subtest failure is reported at the right place")
+	})
+}
+
+func TestSomething(t *testing.T) {
+	if false {
+		t.Errorf("tests don't need to be helpers")
+	}
+}
+
+func BenchmarkSomething(b *testing.B) {
+	b.Fatal("benchmarks don't need to be helpers")
+}
@@ -0,0 +1,12 @@
+package checker_test
+
+import (
+	"testing"
+)
+
+// Non-test files are not checked.
+func assertInLibrary(t *testing.T, cond bool) {
+	if !cond {
+		t.Fatal("condition is false")
+	}
+}
@@ -0,0 +1,24 @@
+package checker_test
+
+import (
+	"testing"
+)
+
+/*! assertEqual reports test failures, but doesn't call t.Helper() */
+func assertEqual(t *testing.T, x, y int) {
+	if x != y {
+		t.Fatalf("%d != %d", x, y)
+	}
+}
+
+/*! checkNoError reports test failures, but doesn't call tb.Helper() */
+func checkNoError(tb testing.TB, err error) {
+	if err != nil {
+		tb.Error(err)
+	}
+}
+
+/*! mustSetup reports test failures, but doesn't call b.Helper() */
+func mustSetup(b *testing.B) {
+	b.Fatal("setup failed")
+}
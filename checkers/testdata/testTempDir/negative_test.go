@@ -0,0 +1,33 @@
+package checker_test
+
+import (
+	"os"
+	"testing"
+)
+
+func TestTempDirOK(t *testing.T) {
+	dir := t.TempDir()
+	_ = dir
+}
+
+func TestTempDirKept(t *testing.T) {
+	dir, err := os.MkdirTemp("", "keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(dir)
+}
+
+func TestSetenvWithoutRestore(t *testing.T) {
+	os.Setenv("APP_MODE", "test")
+}
+
+func TestMainSetup(m *testing.M) {
+	dir, _ := os.MkdirTemp("", "main")
+	defer os.RemoveAll(dir)
+}
+
+func helperWithoutT() {
+	dir, _ := os.MkdirTemp("", "helper")
+	defer os.RemoveAll(dir)
+}
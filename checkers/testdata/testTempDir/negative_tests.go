@@ -0,0 +1,12 @@
+package checker_test
+
+import (
+	"os"
+	"testing"
+)
+
+// Non-test files are not checked.
+func setupInLibrary(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "lib")
+	defer os.RemoveAll(dir)
+}
@@ -0,0 +1,37 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestMkdirTemp(t *testing.T) {
+	/*! use t.TempDir() instead of os.MkdirTemp with deferred os.RemoveAll */
+	dir, err := os.MkdirTemp("", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+}
+
+func BenchmarkIoutilTempDir(b *testing.B) {
+	/*! use b.TempDir() instead of ioutil.TempDir with deferred os.RemoveAll */
+	dir, _ := ioutil.TempDir("", "bench")
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+}
+
+func TestSetenvUnset(t *testing.T) {
+	/*! use t.Setenv instead of os.Setenv with deferred restore */
+	os.Setenv("APP_MODE", "test")
+	defer os.Unsetenv("APP_MODE")
+}
+
+func TestSetenvRestore(t *testing.T) {
+	old := os.Getenv("HOME")
+	/*! use t.Setenv instead of os.Setenv with deferred restore */
+	os.Setenv("HOME", "/tmp")
+	defer func() { os.Setenv("HOME", old) }()
+}
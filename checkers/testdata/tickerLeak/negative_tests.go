@@ -0,0 +1,47 @@
+package checker_test
+
+import (
+	"time"
+)
+
+func tickerDeferredStop() {
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+	for range t.C {
+	}
+}
+
+func tickerStopInClosure(done chan struct{}) {
+	t := time.NewTicker(time.Second)
+	go func() {
+		<-done
+		t.Stop()
+	}()
+	<-t.C
+}
+
+func tickerReturned(d time.Duration) *time.Ticker {
+	t := time.NewTicker(d)
+	t.Reset(d)
+	return t
+}
+
+type poller struct {
+	ticker *time.Ticker
+}
+
+func tickerStored(p *poller) {
+	p.ticker = time.NewTicker(time.Second)
+}
+
+func tickerPassed(d time.Duration) {
+	t := time.NewTicker(d)
+	runWithTicker(t)
+}
+
+func runWithTicker(t *time.Ticker) { t.Stop() }
+
+func timerIsFine() {
+	<-time.NewTimer(time.Second).C
+	<-time.After(time.Second)
+}
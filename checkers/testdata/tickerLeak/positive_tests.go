@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"time"
+)
+
+func tickLoop() {
+	/*! time.Tick leaks the underlying ticker; use time.NewTicker and call Stop */
+	for range time.Tick(time.Second) {
+	}
+}
+
+func tickerNeverStopped() {
+	/*! ticker created by time.NewTicker(time.Second) is never stopped */
+	t := time.NewTicker(time.Second)
+	for range t.C {
+	}
+}
+
+func tickerReset(d time.Duration) {
+	/*! ticker created by time.NewTicker(d) is never stopped */
+	var t = time.NewTicker(d)
+	t.Reset(2 * d)
+	<-t.C
+}
+
+func tickerChannel(d time.Duration) <-chan time.Time {
+	/*! ticker created by time.NewTicker(d) is never stopped */
+	return time.NewTicker(d).C
+}
+
+func tickerDiscarded() {
+	/*! ticker created by time.NewTicker(time.Minute) is never stopped */
+	time.NewTicker(time.Minute)
+}
@@ -0,0 +1,20 @@
+package checker_test
+
+import (
+	"fmt"
+	"time"
+)
+
+func goodLayouts(t time.Time, layout string) {
+	_ = t.Format("2006-01-02")
+	_ = t.Format("2006-01-02T15:04:05.000Z07:00")
+	_ = t.Format("Mon Jan _2 15:04:05.999999999 -0700 MST")
+	_ = t.Format("3:04PM")
+	_ = t.Format("2006-002 __2 -07:00:00 Z0700")
+	_ = t.Format("15:04:05,000")
+	_ = t.Format(time.RFC3339)
+	_ = t.Format(time.Kitchen)
+	_ = t.Format(layout)
+	_, _ = time.Parse("Monday, January 2, 2006", "")
+	_ = fmt.Sprintf("YYYY-MM-DD %d", 2007)
+}
@@ -0,0 +1,22 @@
+package checker_test
+
+import (
+	"time"
+)
+
+const isoLayout = "YYYY-MM-DDThh:mm:ss"
+
+func badLayouts(t time.Time, buf []byte) {
+	/*! YYYY is not a Go time layout element; use 2006 */
+	_ = t.Format("YYYY-MM-DD")
+	/*! hh is not a Go time layout element; use 03 */
+	_, _ = time.Parse("hh:mm", "10:30")
+	/*! YYYY is not a Go time layout element; use 2006 */
+	_, _ = time.ParseInLocation(isoLayout, "", time.UTC)
+	/*! 2007 in time layout is not part of the reference time Mon Jan 2 15:04:05 MST 2006 */
+	_ = t.AppendFormat(buf, "2007-01-02")
+	/*! 08 in time layout is not part of the reference time Mon Jan 2 15:04:05 MST 2006 */
+	_ = t.Format("15:04:08")
+	/*! dd is not a Go time layout element; use 02 */
+	_ = t.Format("dd.01.2006")
+}
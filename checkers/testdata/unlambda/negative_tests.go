@@ -109,3 +109,52 @@ func varFunc() {
 	var varfunc func(x int) int
 	_ = func(x int) int { return varfunc(x) }
 }
+
+type counter struct{ n int }
+
+func (c counter) get(x int) int { return c.n + x }
+
+func (c *counter) inc(x int) { c.n += x }
+
+var globalCounter counter
+
+func receiverTiming() {
+	var c counter
+	c.n = 10
+	// c is copied into method value, so the later
+	// modifications would not be visible.
+	_ = func(x int) int { return c.get(x) }
+
+	var c2 counter
+	_ = func(x int) int { return c2.get(x) }
+	c2 = counter{n: 1}
+
+	var c3 counter
+	_ = func(x int) int { return c3.get(x) }
+	c3.inc(1)
+
+	// Receiver dereference may panic when method value is created.
+	var p *counter
+	_ = func(x int) int { return p.get(x) }
+
+	// Package-level variables can be modified anywhere.
+	_ = func(x int) int { return globalCounter.get(x) }
+
+	// Func-typed field is read when method value is created.
+	var s struct{ fn func(int) int }
+	_ = func(x int) int { return s.fn(x) }
+
+	// Receiver is not a simple variable.
+	cs := []counter{{}}
+	_ = func(x int) int { return cs[0].get(x) }
+}
+
+func discardedResult() {
+	_ = func(x int) { returnInt(x) }
+}
+
+func genericIdentity[T any](x T) T { return x }
+
+func inferredTypeArgs() {
+	_ = func(x int) int { return genericIdentity(x) }
+}
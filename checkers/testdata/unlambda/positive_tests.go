@@ -32,3 +32,43 @@ func methodValues() {
 	/*! replace `func(x int) int { return o.returnInt(x) }` with `o.returnInt` */
 	_ = func(x int) int { return o.returnInt(x) }
 }
+
+func (o *object) reset(x int) {}
+
+func (object) log(s string) {}
+
+func forwardNoResults() {
+	var o object
+	p := &object{}
+
+	/*! replace `func(s string) { o.log(s) }` with `o.log` */
+	_ = func(s string) { o.log(s) }
+
+	/*! replace `func(x int) { p.reset(x) }` with `p.reset` */
+	_ = func(x int) { p.reset(x) }
+}
+
+func identity[T any](x T) T { return x }
+
+func pair[K comparable, V any](k K, v V) V { return v }
+
+func genericFuncs() {
+	/*! replace `func(x int) int { return identity[int](x) }` with `identity[int]` */
+	_ = func(x int) int { return identity[int](x) }
+
+	/*! replace `func(k string, v int) int { return pair[string, int](k, v) }` with `pair[string, int]` */
+	_ = func(k string, v int) int { return pair[string, int](k, v) }
+}
+
+// max is not a builtin before Go 1.21.
+func max(x, y int) int {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+func userDefinedMax() {
+	/*! replace `func(x, y int) int { return max(x, y) }` with `max` */
+	_ = func(x, y int) int { return max(x, y) }
+}
@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"sync"
+)
+
+type config struct {
+	name    string `json:"name"`
+	timeout int    `yaml:"timeout"`
+	Public  int
+	_       int
+	sync.Mutex
+}
+
+type counter struct {
+	n int
+}
+
+func (c *counter) inc() { c.n++ }
+
+type pair struct {
+	a, b int
+}
+
+var defaultPair = pair{1, 2}
+
+type written struct {
+	value string
+}
+
+func newWritten() written {
+	return written{value: "x"}
+}
+
+type embedsCounter struct {
+	counter
+	extra int
+}
+
+func readPromoted(e embedsCounter) int {
+	return e.n + e.extra
+}
+
+func useOtherFile(u usedInOtherFile) int {
+	return u.field
+}
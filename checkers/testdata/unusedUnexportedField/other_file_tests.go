@@ -0,0 +1,5 @@
+package checker_test
+
+type usedInOtherFile struct {
+	field int
+}
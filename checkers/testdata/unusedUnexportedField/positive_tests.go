@@ -0,0 +1,26 @@
+package checker_test
+
+type cache struct {
+	items map[string]int
+
+	/*! field hits is never used in the package */
+	hits int
+
+	/*! field misses is never used in the package */
+	/*! field evictions is never used in the package */
+	misses, evictions int
+}
+
+func (c *cache) get(key string) int {
+	return c.items[key]
+}
+
+func localType() {
+	type point struct {
+		x int
+		/*! field y is never used in the package */
+		y int
+	}
+	p := point{x: 1}
+	_ = p
+}
@@ -0,0 +1,121 @@
+package checker_test
+
+import (
+	"sync"
+)
+
+func addBeforeGoroutine(jobs []func()) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job func()) {
+			defer wg.Done()
+			job()
+		}(job)
+	}
+	wg.Wait()
+}
+
+func goroutineLocalWaitGroup(jobs []func()) {
+	go func() {
+		var wg sync.WaitGroup
+		for _, job := range jobs {
+			wg.Add(1)
+			go func(job func()) {
+				defer wg.Done()
+				job()
+			}(job)
+		}
+		wg.Wait()
+	}()
+}
+
+func reusedWaitGroup(job func()) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job()
+	}()
+	wg.Wait()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job()
+	}()
+	wg.Wait()
+}
+
+func waitInsideLoop(batches [][]func()) {
+	var wg sync.WaitGroup
+	for _, batch := range batches {
+		wg.Wait()
+		wg.Add(len(batch))
+		for _, job := range batch {
+			go func(job func()) {
+				defer wg.Done()
+				job()
+			}(job)
+		}
+	}
+}
+
+func doneWithoutEarlyReturn(job func() error) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		_ = job()
+		wg.Done()
+	}()
+	wg.Wait()
+}
+
+func doneDeferredWithEarlyReturn(job func() error) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := job(); err != nil {
+			return
+		}
+	}()
+	wg.Wait()
+}
+
+func doneBeforeReturn(job func() error) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		wg.Done()
+		if err := job(); err != nil {
+			return
+		}
+	}()
+	wg.Wait()
+}
+
+type waitGroupHolder struct {
+	wg sync.WaitGroup
+}
+
+func (h *waitGroupHolder) fieldWaitGroup(job func()) {
+	h.wg.Wait()
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		job()
+	}()
+}
+
+func deferredWait(jobs []func()) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job func()) {
+			defer wg.Done()
+			job()
+		}(job)
+	}
+}
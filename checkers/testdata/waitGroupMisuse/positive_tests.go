@@ -0,0 +1,71 @@
+package checker_test
+
+import (
+	"sync"
+)
+
+func addInsideGoroutine(jobs []func()) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		job := job
+		go func() {
+			/*! wg.Add should be called before the go statement */
+			wg.Add(1)
+			defer wg.Done()
+			job()
+		}()
+	}
+	wg.Wait()
+}
+
+func addInsideGoroutinePtr(wg *sync.WaitGroup, job func()) {
+	go func() {
+		/*! wg.Add should be called before the go statement */
+		wg.Add(1)
+		job()
+		wg.Done()
+	}()
+}
+
+func addAfterWait(job func()) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job()
+	}()
+	wg.Wait()
+
+	/*! wg.Add after the last Wait call is never waited for */
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job()
+	}()
+}
+
+func addAfterWaitInLoop(jobs []func()) {
+	wg := &sync.WaitGroup{}
+	wg.Wait()
+	for _, job := range jobs {
+		/*! wg.Add after the last Wait call is never waited for */
+		wg.Add(1)
+		go func(job func()) {
+			defer wg.Done()
+			job()
+		}(job)
+	}
+}
+
+func skippedDone(job func() error) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		if err := job(); err != nil {
+			return
+		}
+		/*! wg.Done is skipped on early return paths; use defer wg.Done() */
+		wg.Done()
+	}()
+	wg.Wait()
+}
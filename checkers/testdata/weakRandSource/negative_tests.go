@@ -0,0 +1,28 @@
+package checker_test
+
+import (
+	"crypto/rand"
+	mrand "math/rand"
+	"time"
+)
+
+func jitter(d time.Duration) time.Duration {
+	return d + time.Duration(mrand.Int63n(int64(d)))
+}
+
+func shuffle(keys []string) {
+	mrand.Shuffle(len(keys), func(i, j int) {
+		keys[i], keys[j] = keys[j], keys[i]
+	})
+}
+
+func secureToken() []byte {
+	token := make([]byte, 32)
+	rand.Read(token)
+	return token
+}
+
+func monkeyBusiness() int {
+	monkey := mrand.Intn(10)
+	return monkey
+}
@@ -0,0 +1,37 @@
+package checker_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+)
+
+func newSessionID() string {
+	b := make([]byte, 16)
+	/*! math/rand is predictable and must not be used for newSessionID; use crypto/rand */
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func randomBytes(n int) []byte {
+	nonce := make([]byte, n)
+	/*! math/rand is predictable and must not be used for nonce; use crypto/rand */
+	rand.Read(nonce)
+	return nonce
+}
+
+func generate(r *rand.Rand) (int64, uint64) {
+	/*! math/rand is predictable and must not be used for apiKey; use crypto/rand */
+	apiKey := rand.Int63()
+	/*! math/rand is predictable and must not be used for saltValue; use crypto/rand */
+	var saltValue = r.Uint64()
+	return apiKey, saltValue
+}
+
+type user struct {
+	resetToken int
+}
+
+func resetPassword(u *user) {
+	/*! math/rand is predictable and must not be used for resetPassword; use crypto/rand */
+	u.resetToken = rand.Intn(1000000)
+}
@@ -0,0 +1,18 @@
+package checker_test
+
+import (
+	"fmt"
+	"io"
+)
+
+type notWriter struct{}
+
+func (notWriter) WriteString(s string) {}
+
+func writeOK(w io.Writer, nw notWriter, data []byte, n int) {
+	w.Write(data)
+	fmt.Fprintf(w, "%d", n)
+	io.WriteString(w, "constant")
+	nw.WriteString(fmt.Sprintf("%d", n))
+	w.Write([]byte(fmt.Sprint(n)))
+}
@@ -0,0 +1,36 @@
+package checker_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func writeSprintf(w io.Writer, n int, name string) {
+	/*! could replace with fmt.Fprintf(w, "%d items", n) */
+	w.Write([]byte(fmt.Sprintf("%d items", n)))
+
+	/*! could replace with fmt.Fprintf(w, "name: %s\n", name) */
+	io.WriteString(w, fmt.Sprintf("name: %s\n", name))
+
+	/*! could replace with fmt.Fprintf(os.Stdout, "%v", n) */
+	_, err := os.Stdout.Write([]byte(fmt.Sprintf("%v", n)))
+	_ = err
+}
+
+func writeStringSprintf(buf *bytes.Buffer, sb *strings.Builder, args []interface{}) {
+	/*! could replace with fmt.Fprintf(buf, "%d-%d", 1, 2) */
+	buf.WriteString(fmt.Sprintf("%d-%d", 1, 2))
+
+	/*! could replace with fmt.Fprintf(sb, "%s %s", args...) */
+	sb.WriteString(fmt.Sprintf("%s %s", args...))
+}
+
+func writeStringValueBuffer(n int) string {
+	var b bytes.Buffer
+	/*! could replace with fmt.Fprintf(&b, "%d", n) */
+	b.WriteString(fmt.Sprintf("%d", n))
+	return b.String()
+}
@@ -0,0 +1,153 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "tickerLeak"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects time.Tick calls and time.NewTicker tickers that are never stopped"
+	info.Details = `Before Go 1.23, a ticker that is not stopped is never garbage collected,
+so it keeps its timer alive for the process lifetime.
+Since Go 1.23, unreferenced tickers are collected even if they are not stopped,
+so it's only reported when the target Go version is older than 1.23.
+time.Tick is permitted in main packages and tests.`
+	info.Before = `
+t := time.NewTicker(time.Second)
+for range t.C {
+	poll()
+}`
+	info.After = `
+t := time.NewTicker(time.Second)
+defer t.Stop()
+for range t.C {
+	poll()
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&tickerLeakChecker{ctx: ctx})
+	})
+}
+
+type tickerLeakChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	allowTick bool
+}
+
+func (c *tickerLeakChecker) EnterFile(f *ast.File) bool {
+	if c.ctx.GoVersion.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 23}) {
+		return false
+	}
+	c.allowTick = f.Name.Name == "main" || strings.HasSuffix(c.ctx.Filename, "_test.go")
+	return true
+}
+
+func (c *tickerLeakChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if !c.allowTick && qualifiedName(n.Fun) == "time.Tick" && c.isTimeFunc(n.Fun) {
+				c.warnTick(n)
+			}
+		case *ast.SelectorExpr:
+			// time.NewTicker(d).C can't ever be stopped.
+			call := astcast.ToCallExpr(n.X)
+			if c.isNewTicker(call) {
+				c.warnNeverStopped(call)
+			}
+		case *ast.ExprStmt:
+			if call := astcast.ToCallExpr(n.X); c.isNewTicker(call) {
+				c.warnNeverStopped(call)
+			}
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE && len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+				c.checkTickerVar(decl.Body, n.Lhs[0], n.Rhs[0])
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) == 1 && len(n.Values) == 1 {
+				c.checkTickerVar(decl.Body, n.Names[0], n.Values[0])
+			}
+		}
+		return true
+	})
+}
+
+// checkTickerVar reports `t := time.NewTicker(d)` if t is only
+// used to receive ticks or to be reset.
+// Any other usage (Stop call, return, argument passing) is considered
+// to be a proper lifetime management.
+func (c *tickerLeakChecker) checkTickerVar(body *ast.BlockStmt, lhs, rhs ast.Expr) {
+	call := astcast.ToCallExpr(rhs)
+	if !c.isNewTicker(call) {
+		return
+	}
+	id, ok := lhs.(*ast.Ident)
+	if !ok || id.Name == "_" {
+		return
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil {
+		return
+	}
+
+	handled := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if handled {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if c.isObj(n.X, obj) && (n.Sel.Name == "C" || n.Sel.Name == "Reset") {
+				return false
+			}
+		case *ast.Ident:
+			if c.ctx.TypesInfo.Uses[n] == obj {
+				handled = true
+			}
+		}
+		return true
+	})
+	if !handled {
+		c.warnNeverStopped(call)
+	}
+}
+
+func (c *tickerLeakChecker) isObj(x ast.Expr, obj types.Object) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.Uses[id] == obj
+}
+
+func (c *tickerLeakChecker) isNewTicker(call *ast.CallExpr) bool {
+	return qualifiedName(call.Fun) == "time.NewTicker" && c.isTimeFunc(call.Fun)
+}
+
+func (c *tickerLeakChecker) isTimeFunc(fn ast.Expr) bool {
+	sel, ok := fn.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	obj, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	return ok && obj.Pkg() != nil && obj.Pkg().Path() == "time"
+}
+
+func (c *tickerLeakChecker) warnTick(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "time.Tick leaks the underlying ticker; use time.NewTicker and call Stop")
+}
+
+func (c *tickerLeakChecker) warnNeverStopped(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "ticker created by %s is never stopped", cause)
+}
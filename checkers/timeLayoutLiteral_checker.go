@@ -0,0 +1,155 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "timeLayoutLiteral"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects time layout strings with placeholders or digits that are not part of the reference time"
+	info.Details = `Layouts passed to time.Parse, time.ParseInLocation and Time.Format methods
+are written in terms of the reference time Mon Jan 2 15:04:05 MST 2006.
+Placeholders like YYYY or hh:mm and other digits are copied to the output literally.`
+	info.Before = `t.Format("YYYY-MM-DD hh:mm")`
+	info.After = `t.Format("2006-01-02 15:04")`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&timeLayoutLiteralChecker{ctx: ctx})
+	})
+}
+
+type timeLayoutLiteralChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// timeLayoutPlaceholders maps common layout placeholders
+// from other languages to the Go reference time elements.
+var timeLayoutPlaceholders = map[string]string{
+	"YYYY": "2006",
+	"yyyy": "2006",
+	"YY":   "06",
+	"yy":   "06",
+	"MM":   "01",
+	"DD":   "02",
+	"dd":   "02",
+	"HH":   "15",
+	"hh":   "03",
+	"mm":   "04",
+	"ss":   "05",
+	"SS":   "05",
+}
+
+// timeLayoutElements lists reference time elements that start
+// with a digit or a sign. Longer elements go first.
+var timeLayoutElements = []string{
+	"-07:00:00", "-0700", "-07:00", "-07",
+	"Z07:00:00", "Z0700", "Z07:00", "Z07",
+	"2006", "002", "__2", "_2",
+	"01", "02", "03", "04", "05", "06", "15",
+	"1", "2", "3", "4", "5",
+}
+
+func (c *timeLayoutLiteralChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(astcast.ToSelectorExpr(call.Fun).Sel).(*types.Func)
+	if !ok {
+		return
+	}
+	index := 0
+	switch fn.FullName() {
+	case "time.Parse", "time.ParseInLocation", "(time.Time).Format":
+	case "(time.Time).AppendFormat":
+		index = 1
+	default:
+		return
+	}
+	if len(call.Args) <= index {
+		return
+	}
+	arg := call.Args[index]
+	tv := c.ctx.TypesInfo.Types[arg]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	c.checkLayout(arg, constant.StringVal(tv.Value))
+}
+
+func (c *timeLayoutLiteralChecker) checkLayout(cause ast.Expr, layout string) {
+	for _, word := range strings.FieldsFunc(layout, func(r rune) bool { return !isASCIILetter(r) }) {
+		// Handle ISO 8601 dates like "YYYY-MM-DDThh:mm".
+		word = strings.TrimPrefix(word, "T")
+		if suggestion, ok := timeLayoutPlaceholders[word]; ok {
+			c.warnPlaceholder(cause, word, suggestion)
+			return
+		}
+	}
+
+	for i := 0; i < len(layout); {
+		if n := c.elementLen(layout, i); n != 0 {
+			i += n
+			continue
+		}
+		if layout[i] >= '0' && layout[i] <= '9' {
+			c.warnDigits(cause, digitsAround(layout, i))
+			return
+		}
+		i++
+	}
+}
+
+// elementLen returns the length of a reference time element at layout[i:].
+// Fractional seconds like .000 or ,999 are also accepted.
+func (c *timeLayoutLiteralChecker) elementLen(layout string, i int) int {
+	s := layout[i:]
+	for _, elem := range timeLayoutElements {
+		if strings.HasPrefix(s, elem) {
+			return len(elem)
+		}
+	}
+	if (s[0] == '.' || s[0] == ',') && len(s) > 1 && (s[1] == '0' || s[1] == '9') {
+		n := 1
+		for n < len(s) && s[n] == s[1] {
+			n++
+		}
+		return n
+	}
+	return 0
+}
+
+// digitsAround returns a run of digits that contains s[i].
+func digitsAround(s string, i int) string {
+	isDigit := func(ch byte) bool { return ch >= '0' && ch <= '9' }
+	begin, end := i, i
+	for begin > 0 && isDigit(s[begin-1]) {
+		begin--
+	}
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+	return s[begin:end]
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func (c *timeLayoutLiteralChecker) warnPlaceholder(cause ast.Expr, placeholder, suggestion string) {
+	c.ctx.Warn(cause, "%s is not a Go time layout element; use %s", placeholder, suggestion)
+}
+
+func (c *timeLayoutLiteralChecker) warnDigits(cause ast.Expr, digits string) {
+	c.ctx.Warn(cause, "%s in time layout is not part of the reference time Mon Jan 2 15:04:05 MST 2006", digits)
+}
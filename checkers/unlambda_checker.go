@@ -2,12 +2,15 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/checkers/internal/lintutil"
 	"github.com/go-critic/go-critic/framework/linter"
 	"github.com/go-toolsmith/astcast"
 	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
 )
 
 func init() {
@@ -17,6 +20,9 @@ func init() {
 	info.Summary = "Detects function literals that can be simplified"
 	info.Before = `func(x int) int { return fn(x) }`
 	info.After = `fn`
+	info.Note = `Method values evaluate their receiver when they are created,
+while function literals do it on every call, so method values are
+only suggested for local receivers that are never modified.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
 		return astwalk.WalkerForExpr(&unlambdaChecker{ctx: ctx})
@@ -26,6 +32,18 @@ func init() {
 type unlambdaChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
+
+	fn *ast.FuncDecl
+}
+
+func (c *unlambdaChecker) EnterFile(*ast.File) bool {
+	c.fn = nil
+	return true
+}
+
+func (c *unlambdaChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	c.fn = fn
+	return true
 }
 
 func (c *unlambdaChecker) VisitExpr(x ast.Expr) {
@@ -34,17 +52,28 @@ func (c *unlambdaChecker) VisitExpr(x ast.Expr) {
 		return
 	}
 
-	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
-	if !ok || len(ret.Results) != 1 {
+	var result *ast.CallExpr
+	switch stmt := fn.Body.List[0].(type) {
+	case *ast.ReturnStmt:
+		if len(stmt.Results) != 1 {
+			return
+		}
+		result = astcast.ToCallExpr(stmt.Results[0])
+	case *ast.ExprStmt:
+		// Functions without results are forwarded without return.
+		if fn.Type.Results != nil && len(fn.Type.Results.List) != 0 {
+			return
+		}
+		result = astcast.ToCallExpr(stmt.X)
+	default:
 		return
 	}
 
-	result := astcast.ToCallExpr(ret.Results[0])
-	callable := qualifiedName(result.Fun)
+	callable := c.callableName(result.Fun)
 	if callable == "" {
 		return // Skip tricky cases; only handle simple calls
 	}
-	if isBuiltin(callable) {
+	if isBuiltinForVersion(callable, c.ctx.GoVersion) {
 		return // See #762
 	}
 	if id, ok := result.Fun.(*ast.Ident); ok {
@@ -52,6 +81,12 @@ func (c *unlambdaChecker) VisitExpr(x ast.Expr) {
 		if _, ok := obj.(*types.Var); ok {
 			return // See #888
 		}
+		if c.isGeneric(obj) {
+			return // Would need explicit instantiation
+		}
+	}
+	if sel, ok := result.Fun.(*ast.SelectorExpr); ok && !c.isStableReceiver(sel) {
+		return
 	}
 	fnType := c.ctx.TypeOf(fn)
 	resultType := c.ctx.TypeOf(result.Fun)
@@ -62,7 +97,7 @@ func (c *unlambdaChecker) VisitExpr(x ast.Expr) {
 	n := 0
 	for _, params := range fn.Type.Params.List {
 		for _, id := range params.Names {
-			if !astequal.Expr(id, result.Args[n]) {
+			if n == len(result.Args) || !astequal.Expr(id, result.Args[n]) {
 				return
 			}
 			n++
@@ -74,6 +109,114 @@ func (c *unlambdaChecker) VisitExpr(x ast.Expr) {
 	}
 }
 
+// callableName returns a name of called func that can be used as
+// a func value, including the type arguments of explicitly
+// instantiated generic functions.
+func (c *unlambdaChecker) callableName(fun ast.Expr) string {
+	switch fun := fun.(type) {
+	case *ast.IndexExpr:
+		return c.instanceName(fun, fun.X)
+	case *ast.IndexListExpr:
+		return c.instanceName(fun, fun.X)
+	default:
+		return qualifiedName(fun)
+	}
+}
+
+func (c *unlambdaChecker) instanceName(inst, generic ast.Expr) string {
+	if qualifiedName(generic) == "" || !c.isGeneric(c.ctx.TypesInfo.ObjectOf(identOf(generic))) {
+		return ""
+	}
+	return astfmt.Sprint(inst)
+}
+
+func (c *unlambdaChecker) isGeneric(obj types.Object) bool {
+	fn, ok := obj.(*types.Func)
+	return ok && fn.Type().(*types.Signature).TypeParams().Len() != 0
+}
+
+// isStableReceiver reports whether sel.X evaluates to the same value
+// when method value is created and when the func literal is called.
+func (c *unlambdaChecker) isStableReceiver(sel *ast.SelectorExpr) bool {
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	recv, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Var)
+	if !ok {
+		return true // Package-qualified function
+	}
+	s := c.ctx.TypesInfo.Selections[sel]
+	if s == nil || s.Kind() != types.MethodVal || len(s.Index()) != 1 {
+		// Func-typed fields are read when method value is created,
+		// promoted methods may dereference embedded pointers.
+		return false
+	}
+	if c.isPointer(recv.Type()) && !c.hasPointerRecv(s) {
+		return false // Implicit dereference can panic when method value is created
+	}
+	if c.fn == nil || c.fn.Body == nil || recv.Parent() == recv.Pkg().Scope() {
+		return false
+	}
+	return !c.isModified(c.fn.Body, recv)
+}
+
+// isModified reports whether v is assigned, incremented or has its
+// address taken inside body, including modifications of its parts.
+func (c *unlambdaChecker) isModified(body ast.Node, v *types.Var) bool {
+	isVar := func(x ast.Expr) bool {
+		for {
+			switch y := x.(type) {
+			case *ast.ParenExpr:
+				x = y.X
+			case *ast.SelectorExpr:
+				x = y.X
+			case *ast.IndexExpr:
+				x = y.X
+			case *ast.StarExpr:
+				x = y.X
+			case *ast.Ident:
+				return c.ctx.TypesInfo.Uses[y] == v
+			default:
+				return false
+			}
+		}
+	}
+	return lintutil.ContainsNode(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			return n.Op == token.AND && isVar(n.X)
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if isVar(lhs) {
+					return true
+				}
+			}
+		case *ast.IncDecStmt:
+			return isVar(n.X)
+		case *ast.RangeStmt:
+			return n.Tok == token.ASSIGN && (isVar(n.Key) || n.Value != nil && isVar(n.Value))
+		case *ast.SelectorExpr:
+			// Pointer receiver methods take the address implicitly.
+			s := c.ctx.TypesInfo.Selections[n]
+			if s == nil || s.Kind() != types.MethodVal || !isVar(n.X) {
+				return false
+			}
+			return c.hasPointerRecv(s) && !c.isPointer(v.Type())
+		}
+		return false
+	})
+}
+
+func (c *unlambdaChecker) hasPointerRecv(s *types.Selection) bool {
+	return c.isPointer(s.Obj().Type().(*types.Signature).Recv().Type())
+}
+
+func (c *unlambdaChecker) isPointer(typ types.Type) bool {
+	_, ok := typ.Underlying().(*types.Pointer)
+	return ok
+}
+
 func (c *unlambdaChecker) warn(cause ast.Node, suggestion string) {
 	c.ctx.Warn(cause, "replace `%s` with `%s`", cause, suggestion)
 }
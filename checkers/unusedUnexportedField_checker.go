@@ -0,0 +1,106 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "unusedUnexportedField"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects unexported struct fields that are never used in the package"
+	info.Details = `Fields with struct tags are ignored, since they're likely
+to be accessed via reflection or serialization.`
+	info.Before = `
+type cache struct {
+	items map[string]int
+	hits  int // never read or written
+}`
+	info.After = `
+type cache struct {
+	items map[string]int
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForTypeExpr(&unusedUnexportedFieldChecker{ctx: ctx}, ctx.TypesInfo)
+	})
+}
+
+type unusedUnexportedFieldChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// usedFields is collected once per package.
+	info       *types.Info
+	usedFields map[*types.Var]bool
+}
+
+func (c *unusedUnexportedFieldChecker) EnterFile(f *ast.File) bool {
+	if c.info != c.ctx.TypesInfo {
+		c.info = c.ctx.TypesInfo
+		c.usedFields = c.collectUsedFields()
+	}
+	return true
+}
+
+func (c *unusedUnexportedFieldChecker) VisitTypeExpr(x ast.Expr) {
+	typ, ok := x.(*ast.StructType)
+	if !ok {
+		return
+	}
+	for _, field := range typ.Fields.List {
+		if field.Tag != nil {
+			continue
+		}
+		for _, id := range field.Names {
+			if id.Name == "_" || id.IsExported() {
+				continue
+			}
+			obj, ok := c.ctx.TypesInfo.Defs[id].(*types.Var)
+			if ok && !c.usedFields[obj] {
+				c.warn(id)
+			}
+		}
+	}
+}
+
+// collectUsedFields returns all struct fields that are referenced
+// anywhere in the current package.
+// Type info covers all package files, not only the current one.
+func (c *unusedUnexportedFieldChecker) collectUsedFields() map[*types.Var]bool {
+	used := make(map[*types.Var]bool)
+	for _, obj := range c.ctx.TypesInfo.Uses {
+		if v, ok := obj.(*types.Var); ok && v.IsField() {
+			used[v] = true
+		}
+	}
+	for _, sel := range c.ctx.TypesInfo.Selections {
+		if v, ok := sel.Obj().(*types.Var); ok {
+			used[v] = true
+		}
+	}
+	// Unkeyed composite literals initialize all fields.
+	for expr, tv := range c.ctx.TypesInfo.Types {
+		lit, ok := expr.(*ast.CompositeLit)
+		if !ok || len(lit.Elts) == 0 {
+			continue
+		}
+		if _, keyed := lit.Elts[0].(*ast.KeyValueExpr); keyed {
+			continue
+		}
+		if typ, ok := tv.Type.Underlying().(*types.Struct); ok {
+			for i := 0; i < typ.NumFields(); i++ {
+				used[typ.Field(i)] = true
+			}
+		}
+	}
+	return used
+}
+
+func (c *unusedUnexportedFieldChecker) warn(id *ast.Ident) {
+	c.ctx.Warn(id, "field %s is never used in the package", id)
+}
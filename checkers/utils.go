@@ -3,6 +3,8 @@ package checkers
 import (
 	"go/ast"
 	"go/types"
+	"path"
+	"strconv"
 	"strings"
 
 	"github.com/go-critic/go-critic/framework/linter"
@@ -229,10 +231,27 @@ var goBuiltins = map[string]bool{
 	"println": true,
 	"real":    true,
 	"recover": true,
+
+	// Functions added in Go 1.21
+	"clear": true,
+	"max":   true,
+	"min":   true,
+}
+
+// goBuiltinsSince maps predeclared identifiers that were added
+// after Go 1.0 to the version that introduced them.
+var goBuiltinsSince = map[string]linter.GoVersion{
+	"clear": {Major: 1, Minor: 21},
+	"max":   {Major: 1, Minor: 21},
+	"min":   {Major: 1, Minor: 21},
 }
 
-// isBuiltin reports whether sym belongs to a predefined identifier set.
-func isBuiltin(sym string) bool {
+// isBuiltinForVersion reports whether sym belongs to a predefined identifier set
+// that is available in the given Go version.
+func isBuiltinForVersion(sym string, v linter.GoVersion) bool {
+	if since, ok := goBuiltinsSince[sym]; ok && !v.GreaterOrEqual(since) {
+		return false
+	}
 	return goBuiltins[sym]
 }
 
@@ -260,6 +279,28 @@ func isUnitTestFunc(ctx *linter.CheckerContext, fn *ast.FuncDecl) bool {
 	return false
 }
 
+// importedPkgName returns a name under which package with a given path
+// is imported inside f. Returns empty string if it's not imported or
+// if it's imported for side effects or with a dot import.
+//
+// Package name is expected to match the last import path element,
+// which is true for all standard library packages.
+func importedPkgName(f *ast.File, pkgPath string) string {
+	for _, spec := range f.Imports {
+		specPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || specPath != pkgPath {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			return path.Base(pkgPath)
+		case spec.Name.Name != "_" && spec.Name.Name != ".":
+			return spec.Name.Name
+		}
+	}
+	return ""
+}
+
 // qualifiedName returns called expr fully-quallified name.
 //
 // It works for simple identifiers like f => "f" and identifiers
@@ -307,3 +348,65 @@ func identOf(x ast.Node) *ast.Ident {
 		return nil
 	}
 }
+
+// isReadOnlyCopyUse reports whether the id variable use only reads
+// the memory that is owned by the variable, so the variable can be
+// replaced with a pointer to the original value.
+//
+// The parents are the id ancestors, the closest one is the last.
+// The use must start with a field selection or a value receiver method call.
+// Pointer receiver method calls and array slicing anywhere
+// in the selector and index chain write through the pointer.
+func isReadOnlyCopyUse(ctx *linter.CheckerContext, parents []ast.Node, id *ast.Ident) bool {
+	var cur ast.Expr = id
+	selected := false
+loop:
+	for i := len(parents) - 1; i >= 0; i-- {
+		switch p := parents[i].(type) {
+		case *ast.ParenExpr:
+			cur = p
+			continue
+		case *ast.SelectorExpr:
+			if p.X != cur {
+				break loop
+			}
+			selection := ctx.TypesInfo.Selections[p]
+			if selection == nil {
+				return false
+			}
+			switch selection.Kind() {
+			case types.FieldVal:
+				if selection.Indirect() {
+					return true // Shared memory from now on
+				}
+			case types.MethodVal:
+				sig := selection.Obj().Type().(*types.Signature)
+				if _, isPtr := sig.Recv().Type().(*types.Pointer); !isPtr {
+					return true
+				}
+				_, isPtr := ctx.TypeOf(cur).Underlying().(*types.Pointer)
+				return selected && isPtr
+			default:
+				return false
+			}
+			selected = true
+		case *ast.IndexExpr:
+			if p.X != cur || !selected {
+				break loop
+			}
+			if _, ok := ctx.TypeOf(cur).Underlying().(*types.Array); !ok {
+				return true
+			}
+		case *ast.SliceExpr:
+			if p.X != cur || !selected {
+				break loop
+			}
+			_, isArray := ctx.TypeOf(cur).Underlying().(*types.Array)
+			return !isArray
+		default:
+			break loop
+		}
+		cur = parents[i].(ast.Expr)
+	}
+	return selected
+}
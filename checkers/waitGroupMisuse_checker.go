@@ -0,0 +1,204 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astp"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "waitGroupMisuse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects racy sync.WaitGroup Add/Done/Wait usage patterns"
+	info.Details = "Reports Add calls inside the goroutine they guard, Add calls after the last Wait and Done calls skipped by early returns."
+	info.Before = `
+go func() {
+	wg.Add(1)
+	defer wg.Done()
+	work()
+}()
+wg.Wait()`
+	info.After = `
+wg.Add(1)
+go func() {
+	defer wg.Done()
+	work()
+}()
+wg.Wait()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForFuncDecl(&waitGroupMisuseChecker{ctx: ctx})
+	})
+}
+
+type waitGroupMisuseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// wgCall is a sync.WaitGroup method call found inside a function body.
+type wgCall struct {
+	call *ast.CallExpr
+	obj  types.Object
+
+	// loop is an outermost loop statement that contains the call.
+	// Nil if the call is not inside a loop.
+	loop ast.Stmt
+}
+
+func (c *waitGroupMisuseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	c.checkFuncBody(decl.Body)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			if lit, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				c.checkGoroutine(lit)
+			}
+		case *ast.FuncLit:
+			c.checkFuncBody(n.Body)
+		}
+		return true
+	})
+}
+
+// checkFuncBody finds Add calls that come after the last Wait call
+// on the same function-local WaitGroup.
+//
+// Deferred Wait is executed after the whole body,
+// so it waits for all Add calls.
+func (c *waitGroupMisuseChecker) checkFuncBody(body *ast.BlockStmt) {
+	var adds []wgCall
+	lastWait := make(map[types.Object]wgCall)
+	deferredWait := make(map[types.Object]bool)
+	var loop ast.Stmt
+	var walk func(n ast.Node) bool
+	walk = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.DeferStmt:
+			obj, method := c.wgMethod(n.Call)
+			if obj != nil && method == "Wait" {
+				deferredWait[obj] = true
+				return false
+			}
+		case *ast.ForStmt, *ast.RangeStmt:
+			if loop == nil {
+				loop = n.(ast.Stmt)
+				ast.Inspect(n, func(x ast.Node) bool {
+					if x == n {
+						return true
+					}
+					return walk(x)
+				})
+				loop = nil
+				return false
+			}
+		case *ast.CallExpr:
+			obj, method := c.wgMethod(n)
+			if obj == nil || !c.isLocalTo(obj, body) {
+				return true
+			}
+			switch method {
+			case "Add":
+				adds = append(adds, wgCall{call: n, obj: obj, loop: loop})
+			case "Wait":
+				lastWait[obj] = wgCall{call: n, obj: obj, loop: loop}
+			}
+		}
+		return true
+	}
+	ast.Inspect(body, walk)
+
+	for _, add := range adds {
+		wait, ok := lastWait[add.obj]
+		if !ok || deferredWait[add.obj] || add.call.Pos() < wait.call.Pos() {
+			continue
+		}
+		if add.loop != nil && add.loop == wait.loop {
+			continue // Next loop iteration will wait for it
+		}
+		c.warnAddAfterWait(add.call)
+	}
+}
+
+// checkGoroutine inspects a `go func() {...}()` body for Add calls
+// on captured WaitGroups and for Done calls that can be skipped.
+func (c *waitGroupMisuseChecker) checkGoroutine(lit *ast.FuncLit) {
+	var returns []*ast.ReturnStmt
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.DeferStmt:
+			return false
+		case *ast.ReturnStmt:
+			returns = append(returns, n)
+		case *ast.CallExpr:
+			obj, method := c.wgMethod(n)
+			if obj != nil && method == "Add" && !c.isLocalTo(obj, lit.Body) {
+				c.warnAddInGoroutine(n)
+			}
+		}
+		return true
+	})
+
+	for _, stmt := range lit.Body.List {
+		call := astcast.ToCallExpr(astcast.ToExprStmt(stmt).X)
+		if _, method := c.wgMethod(call); method != "Done" {
+			continue
+		}
+		for _, ret := range returns {
+			if ret.Pos() < call.Pos() {
+				c.warnSkippedDone(call)
+				break
+			}
+		}
+	}
+}
+
+// wgMethod returns a WaitGroup object and a method name
+// if call is a sync.WaitGroup method call.
+func (c *waitGroupMisuseChecker) wgMethod(call *ast.CallExpr) (types.Object, string) {
+	sel := astcast.ToSelectorExpr(call.Fun)
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return nil, ""
+	}
+	switch fn.FullName() {
+	case "(*sync.WaitGroup).Add", "(*sync.WaitGroup).Done", "(*sync.WaitGroup).Wait":
+	default:
+		return nil, ""
+	}
+	id := identOf(sel.X)
+	if id == nil || astp.IsSelectorExpr(sel.X) {
+		return nil, ""
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil {
+		return nil, ""
+	}
+	return obj, fn.Name()
+}
+
+func (c *waitGroupMisuseChecker) isLocalTo(obj types.Object, body *ast.BlockStmt) bool {
+	return obj.Pos() >= body.Pos() && obj.Pos() < body.End()
+}
+
+func (c *waitGroupMisuseChecker) warnAddInGoroutine(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s should be called before the go statement", cause.Fun)
+}
+
+func (c *waitGroupMisuseChecker) warnAddAfterWait(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s after the last Wait call is never waited for", cause.Fun)
+}
+
+func (c *waitGroupMisuseChecker) warnSkippedDone(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s is skipped on early return paths; use defer %s()",
+		cause.Fun, cause.Fun)
+}
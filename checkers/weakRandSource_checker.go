@@ -0,0 +1,148 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "weakRandSource"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"patterns": {
+			Value: "token,secret,key,nonce,salt,password,passwd,session,csrf,otp,iv",
+			Usage: "comma-separated list of identifier words that denote security-sensitive values",
+		},
+	}
+	info.Summary = "Detects math/rand usage for generating tokens, keys and other secrets"
+	info.Details = `math/rand output is predictable and must not be used for security-sensitive values.
+A math/rand call is reported when it is inside a function or assigned to a variable
+whose name contains one of the pattern words.`
+	info.Before = `
+func newSessionID() string {
+	b := make([]byte, 16)
+	rand.Read(b) // math/rand
+	return hex.EncodeToString(b)
+}`
+	info.After = `
+func newSessionID() string {
+	b := make([]byte, 16)
+	rand.Read(b) // crypto/rand
+	return hex.EncodeToString(b)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		c := &weakRandSourceChecker{
+			ctx:      ctx,
+			patterns: make(map[string]bool),
+		}
+		for _, p := range strings.Split(info.Params.String("patterns"), ",") {
+			if p = strings.ToLower(strings.TrimSpace(p)); p != "" {
+				c.patterns[p] = true
+			}
+		}
+		return astwalk.WalkerForFuncDecl(c)
+	})
+}
+
+type weakRandSourceChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	patterns map[string]bool
+
+	// reported holds calls that were already reported inside the current function.
+	reported map[*ast.CallExpr]bool
+}
+
+func (c *weakRandSourceChecker) EnterFile(f *ast.File) bool {
+	return importedPkgName(f, "math/rand") != "" || importedPkgName(f, "math/rand/v2") != ""
+}
+
+func (c *weakRandSourceChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	c.reported = make(map[*ast.CallExpr]bool)
+
+	if c.isSensitive(decl.Name.Name) {
+		c.checkRandCalls(decl.Body, decl.Name.Name)
+		return
+	}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if id := identOf(lhs); id != nil && c.isSensitive(id.Name) {
+					c.checkRandCalls(n.Rhs[i], id.Name)
+				}
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) != len(n.Values) {
+				return true
+			}
+			for i, id := range n.Names {
+				if c.isSensitive(id.Name) {
+					c.checkRandCalls(n.Values[i], id.Name)
+				}
+			}
+		case *ast.CallExpr:
+			// rand.Read(nonce) fills a sensitive buffer.
+			if c.isRandFunc(n) && astcast.ToSelectorExpr(n.Fun).Sel.Name == "Read" && len(n.Args) == 1 {
+				if id := identOf(n.Args[0]); id != nil && c.isSensitive(id.Name) {
+					c.warn(n, id.Name)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// checkRandCalls reports all math/rand calls inside n.
+func (c *weakRandSourceChecker) checkRandCalls(n ast.Node, name string) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && c.isRandFunc(call) {
+			c.warn(call, name)
+		}
+		return true
+	})
+}
+
+// isRandFunc reports whether call is a math/rand function or
+// a method of a math/rand type, like *rand.Rand.
+func (c *weakRandSourceChecker) isRandFunc(call *ast.CallExpr) bool {
+	fn, ok := c.ctx.TypesInfo.ObjectOf(astcast.ToSelectorExpr(call.Fun).Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+	switch fn.Pkg().Path() {
+	case "math/rand", "math/rand/v2":
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *weakRandSourceChecker) isSensitive(name string) bool {
+	for _, w := range splitIdentWords(name) {
+		if c.patterns[w] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *weakRandSourceChecker) warn(cause *ast.CallExpr, name string) {
+	if c.reported[cause] {
+		return
+	}
+	c.reported[cause] = true
+	c.ctx.Warn(cause, "math/rand is predictable and must not be used for %s; use crypto/rand", name)
+}
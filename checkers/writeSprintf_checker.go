@@ -0,0 +1,129 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "writeSprintf"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects fmt.Sprintf results written to io.Writer instead of fmt.Fprintf usage"
+	info.Before = `w.Write([]byte(fmt.Sprintf("%d items", n)))`
+	info.After = `fmt.Fprintf(w, "%d items", n)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) linter.FileWalker {
+		return astwalk.WalkerForExpr(&writeSprintfChecker{ctx: ctx})
+	})
+}
+
+type writeSprintfChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *writeSprintfChecker) VisitExpr(x ast.Expr) {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return
+	}
+
+	switch {
+	case fn.FullName() == "io.WriteString" && len(call.Args) == 2:
+		// io.WriteString(w, fmt.Sprintf(...))
+		c.checkWrite(call, call.Args[0], call.Args[1])
+	case fn.Name() == "WriteString" && len(call.Args) == 1:
+		// w.WriteString(fmt.Sprintf(...))
+		if w := c.writerOf(sel.X); w != nil {
+			c.checkWrite(call, w, call.Args[0])
+		}
+	case fn.Name() == "Write" && len(call.Args) == 1:
+		// w.Write([]byte(fmt.Sprintf(...)))
+		conv := astcast.ToCallExpr(call.Args[0])
+		if len(conv.Args) != 1 || !c.isBytesConversion(conv) {
+			return
+		}
+		if w := c.writerOf(sel.X); w != nil {
+			c.checkWrite(call, w, conv.Args[0])
+		}
+	}
+}
+
+func (c *writeSprintfChecker) checkWrite(call *ast.CallExpr, w, data ast.Expr) {
+	sprintf := astcast.ToCallExpr(data)
+	sel, ok := sprintf.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.FullName() != "fmt.Sprintf" {
+		return
+	}
+	fprintf := &ast.CallExpr{
+		Fun:      &ast.SelectorExpr{X: sel.X, Sel: ast.NewIdent("Fprintf")},
+		Args:     append([]ast.Expr{w}, sprintf.Args...),
+		Ellipsis: sprintf.Ellipsis,
+	}
+	c.warn(call, fprintf)
+}
+
+// writerOf returns an io.Writer expression for x: x itself or &x,
+// if only the pointer type implements io.Writer. Returns nil if neither does.
+func (c *writeSprintfChecker) writerOf(x ast.Expr) ast.Expr {
+	typ := c.ctx.TypeOf(x)
+	if c.isWriter(typ) {
+		return x
+	}
+	switch astutil.Unparen(x).(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.StarExpr:
+		if c.isWriter(types.NewPointer(typ)) {
+			return &ast.UnaryExpr{Op: token.AND, X: x}
+		}
+	}
+	return nil
+}
+
+// isWriter reports whether typ implements io.Writer.
+func (c *writeSprintfChecker) isWriter(typ types.Type) bool {
+	sel := types.NewMethodSet(typ).Lookup(nil, "Write")
+	if sel == nil {
+		return false
+	}
+	sig := sel.Obj().Type().(*types.Signature)
+	if sig.Params().Len() != 1 || sig.Results().Len() != 2 {
+		return false
+	}
+	return types.Identical(sig.Params().At(0).Type(), types.NewSlice(types.Typ[types.Byte]))
+}
+
+func (c *writeSprintfChecker) isBytesConversion(conv *ast.CallExpr) bool {
+	tv, ok := c.ctx.TypesInfo.Types[conv.Fun]
+	return ok && tv.IsType() &&
+		types.Identical(tv.Type, types.NewSlice(types.Typ[types.Byte]))
+}
+
+func (c *writeSprintfChecker) warn(cause *ast.CallExpr, suggestion *ast.CallExpr) {
+	s := astfmt.Sprint(suggestion)
+	fix := linter.QuickFix{
+		From:        cause.Pos(),
+		To:          cause.End(),
+		Replacement: []byte(s),
+	}
+	c.ctx.WarnFixable(cause, fix, "could replace with %s", s)
+}
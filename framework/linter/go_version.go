@@ -0,0 +1,69 @@
+package linter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GoVersion describes a Go language version.
+//
+// Zero value describes an unknown (any) version;
+// it's treated as the most recent Go version.
+type GoVersion struct {
+	Major int
+	Minor int
+}
+
+// ParseGoVersion parses a Go version string.
+// Accepted forms include "1.18", "1.18.2" and "go1.18".
+// Empty string results in a zero value GoVersion.
+func ParseGoVersion(version string) (GoVersion, error) {
+	var result GoVersion
+	if version == "" {
+		return result, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(version, "go"), ".")
+	if len(parts) < 2 {
+		return result, fmt.Errorf("invalid Go version %q", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return result, fmt.Errorf("invalid Go version %q: %v", version, err)
+	}
+	// Trim pre-release suffixes like "1.21rc2".
+	minorPart := parts[1]
+	if i := strings.IndexFunc(minorPart, func(r rune) bool { return r < '0' || r > '9' }); i != -1 {
+		minorPart = minorPart[:i]
+	}
+	minor, err := strconv.Atoi(minorPart)
+	if err != nil {
+		return result, fmt.Errorf("invalid Go version %q: %v", version, err)
+	}
+	result.Major = major
+	result.Minor = minor
+	return result, nil
+}
+
+// IsAny reports whether v is an unknown version.
+func (v GoVersion) IsAny() bool { return v.Major == 0 }
+
+// GreaterOrEqual reports whether v >= other.
+// Unknown version is greater or equal than any other version.
+func (v GoVersion) GreaterOrEqual(other GoVersion) bool {
+	if v.IsAny() {
+		return true
+	}
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+// String returns a "go1.N" version representation.
+func (v GoVersion) String() string {
+	if v.IsAny() {
+		return "any"
+	}
+	return fmt.Sprintf("go%d.%d", v.Major, v.Minor)
+}
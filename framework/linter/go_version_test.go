@@ -0,0 +1,55 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestParseGoVersion(t *testing.T) {
+	tests := []struct {
+		input string
+		want  GoVersion
+	}{
+		{"", GoVersion{}},
+		{"1.12", GoVersion{Major: 1, Minor: 12}},
+		{"1.20.3", GoVersion{Major: 1, Minor: 20}},
+		{"go1.21", GoVersion{Major: 1, Minor: 21}},
+		{"go1.22rc1", GoVersion{Major: 1, Minor: 22}},
+	}
+
+	for _, test := range tests {
+		have, err := ParseGoVersion(test.input)
+		if err != nil {
+			t.Errorf("parse(%q): unexpected error: %v", test.input, err)
+			continue
+		}
+		if have != test.want {
+			t.Errorf("parse(%q):\nhave: %v\nwant: %v", test.input, have, test.want)
+		}
+	}
+
+	for _, input := range []string{"1", "go", "x.y", "1.x"} {
+		if _, err := ParseGoVersion(input); err == nil {
+			t.Errorf("parse(%q): expected an error", input)
+		}
+	}
+}
+
+func TestGoVersionGreaterOrEqual(t *testing.T) {
+	go120 := GoVersion{Major: 1, Minor: 20}
+	tests := []struct {
+		v    GoVersion
+		want bool
+	}{
+		{GoVersion{}, true},
+		{GoVersion{Major: 1, Minor: 19}, false},
+		{GoVersion{Major: 1, Minor: 20}, true},
+		{GoVersion{Major: 1, Minor: 21}, true},
+		{GoVersion{Major: 2, Minor: 0}, true},
+	}
+
+	for _, test := range tests {
+		if have := test.v.GreaterOrEqual(go120); have != test.want {
+			t.Errorf("%v >= %v: have %v, want %v", test.v, go120, have, test.want)
+		}
+	}
+}
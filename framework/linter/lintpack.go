@@ -134,6 +134,27 @@ type Warning struct {
 
 	// Text is warning message without source location info.
 	Text string
+
+	// Suggestion is a quick fix for a given problem.
+	// QuickFix is analysis.TextEdit and can be used to
+	// construct an analysis.SuggestedFix object.
+	//
+	// For convenience, there is Warning.HasQuickFix() method
+	// that reports whether Suggestion has something meaningful.
+	Suggestion QuickFix
+}
+
+// HasQuickFix reports whether this warning has a suggested fix.
+func (warn Warning) HasQuickFix() bool {
+	return warn.Suggestion.Replacement != nil
+}
+
+// QuickFix is our analysis.TextEdit; we're using it here to avoid
+// direct analysis package dependency (and possible import cycles).
+type QuickFix struct {
+	From        token.Pos
+	To          token.Pos
+	Replacement []byte
 }
 
 // NewChecker returns initialized checker identified by an info.
@@ -161,6 +182,21 @@ type Context struct {
 	// Filename is a currently checked file name.
 	Filename string
 
+	// FileSource is a currently checked file contents,
+	// exactly as it was parsed.
+	// It's nil if the integrating application can't provide it.
+	//
+	// Checkers should use SourceText instead of reading files,
+	// since the checked file contents may differ from the file on disk.
+	FileSource []byte
+
+	// file is a currently checked file token info.
+	file *token.File
+
+	// GoVersion is a Go language version of the package being checked.
+	// Zero value means that the latest Go version is assumed.
+	GoVersion GoVersion
+
 	// Require records what optional resources are required
 	// by the checkers set that use this context.
 	//
@@ -211,6 +247,11 @@ func (c *Context) SetPackageInfo(info *types.Info, pkg *types.Package) {
 // Must be called for every source code file being checked.
 func (c *Context) SetFileInfo(name string, f *ast.File) {
 	c.Filename = name
+	c.FileSource = nil
+	c.file = nil
+	if c.FileSet != nil {
+		c.file = c.FileSet.File(f.Pos())
+	}
 	if c.Require.PkgObjects {
 		resolvePkgObjects(c, f)
 	}
@@ -219,6 +260,36 @@ func (c *Context) SetFileInfo(name string, f *ast.File) {
 	}
 }
 
+// SetFileSource sets the currently checked file contents.
+// The src must be the same source that was parsed.
+//
+// Must be called after SetFileInfo, since it resets the file source.
+// Sources that don't match the parsed file size are ignored.
+func (c *Context) SetFileSource(src []byte) {
+	c.FileSource = nil
+	if c.file != nil && c.file.Size() == len(src) {
+		c.FileSource = src
+	}
+}
+
+// SourceText returns the currently checked file source in [from, to) range.
+// Returns nil if the file source is not available or the range
+// doesn't belong to the current file.
+//
+// Returned slice must not be modified.
+func (c *Context) SourceText(from, to token.Pos) []byte {
+	if c.FileSource == nil || c.file == nil || from > to {
+		return nil
+	}
+	base := c.file.Base()
+	if int(from) < base || int(to) > base+c.file.Size() {
+		return nil
+	}
+	start := int(from) - base
+	end := int(to) - base
+	return c.FileSource[start:end:end]
+}
+
 // CheckerContext is checker-local context copy.
 // Fields that are not from Context itself are writeable.
 type CheckerContext struct {
@@ -238,6 +309,15 @@ func (ctx *CheckerContext) Warn(node ast.Node, format string, args ...interface{
 	})
 }
 
+// WarnFixable emits a warning with a fix suggestion provided by the caller.
+func (ctx *CheckerContext) WarnFixable(node ast.Node, fix QuickFix, format string, args ...interface{}) {
+	ctx.warnings = append(ctx.warnings, Warning{
+		Text:       ctx.printer.Sprintf(format, args...),
+		Node:       node,
+		Suggestion: fix,
+	})
+}
+
 // UnknownType is a special sentinel value that is returned from the CheckerContext.TypeOf
 // method instead of the nil type.
 var UnknownType types.Type = types.Typ[types.Invalid]
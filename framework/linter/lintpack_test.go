@@ -0,0 +1,45 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+)
+
+func TestSourceText(t *testing.T) {
+	src := []byte("package p\n\nvar x = 1 + 2\n")
+	fset := token.NewFileSet()
+	var sources FileSources
+	f, err := sources.ParseFile(fset, "p.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary := f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0]
+
+	ctx := NewContext(fset, nil)
+	ctx.SetFileInfo("p.go", f)
+	if have := ctx.SourceText(binary.Pos(), binary.End()); have != nil {
+		t.Errorf("source text without file source: %q", have)
+	}
+
+	ctx.SetFileSource(sources.Get("p.go"))
+	if have := string(ctx.SourceText(binary.Pos(), binary.End())); have != "1 + 2" {
+		t.Errorf("source text mismatch: %q", have)
+	}
+	if have := ctx.SourceText(binary.Pos(), binary.Pos()+100); have != nil {
+		t.Errorf("out of range source text: %q", have)
+	}
+
+	// Sources that were not parsed are ignored.
+	ctx.SetFileSource([]byte("package p\n"))
+	if ctx.FileSource != nil {
+		t.Errorf("mismatching source is accepted")
+	}
+
+	// File info change resets the source.
+	ctx.SetFileSource(src)
+	ctx.SetFileInfo("p.go", f)
+	if ctx.FileSource != nil {
+		t.Errorf("file source is not reset")
+	}
+}
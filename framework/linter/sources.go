@@ -0,0 +1,39 @@
+package linter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sync"
+)
+
+// FileSources records the contents of the parsed files,
+// so they can be passed to the Context.SetFileSource.
+//
+// Zero value is ready to use.
+type FileSources struct {
+	mu      sync.Mutex
+	sources map[string][]byte
+}
+
+// ParseFile parses src and records it as a filename contents.
+//
+// It can be used as a packages.Config.ParseFile hook,
+// since it has the same signature and behavior as the default parser.
+// Safe for concurrent use.
+func (s *FileSources) ParseFile(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+	s.mu.Lock()
+	if s.sources == nil {
+		s.sources = make(map[string][]byte)
+	}
+	s.sources[filename] = src
+	s.mu.Unlock()
+	return parser.ParseFile(fset, filename, src, parser.AllErrors|parser.ParseComments)
+}
+
+// Get returns the recorded filename contents or nil.
+func (s *FileSources) Get(filename string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sources[filename]
+}
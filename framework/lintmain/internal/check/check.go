@@ -12,7 +12,6 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"runtime"
 	"sort"
 	"strings"
 	"sync"
@@ -41,6 +40,8 @@ func Main() {
 		{"load program", p.loadProgram},
 		{"init checkers", p.initCheckers},
 		{"run checkers", p.runCheckers},
+		{"print report", p.printReport},
+		{"write metrics", p.writeMetrics},
 		{"exit if found issues", p.exit},
 	}
 
@@ -56,6 +57,8 @@ type program struct {
 
 	fset *token.FileSet
 
+	sources linter.FileSources
+
 	loadedPackages []*packages.Package
 
 	infoList []*linter.CheckerInfo
@@ -66,6 +69,12 @@ type program struct {
 
 	foundIssues bool
 
+	// reported are warnings collected for the non-text output formats.
+	reported []reportedWarning
+
+	// findings counts warnings for the -metricsFile output.
+	findings findingsCounter
+
 	checkerParams boundCheckerParams
 
 	filters struct {
@@ -79,6 +88,10 @@ type program struct {
 	gopath  string
 	goroot  string
 
+	goVersion linter.GoVersion
+
+	format             string
+	metricsFile        string
 	exitCode           int
 	checkTests         bool
 	checkGenerated     bool
@@ -108,6 +121,7 @@ func (p *program) runCheckers() error {
 
 func (p *program) checkPackage(pkg *packages.Package) {
 	p.ctx.SetPackageInfo(pkg.TypesInfo, pkg.Types)
+	p.ctx.GoVersion = p.packageGoVersion(pkg)
 	for _, f := range pkg.Syntax {
 		filename := p.getFilename(f)
 		if !p.checkTests && strings.HasSuffix(filename, "_test.go") {
@@ -117,11 +131,12 @@ func (p *program) checkPackage(pkg *packages.Package) {
 			continue
 		}
 		p.ctx.SetFileInfo(filename, f)
-		p.checkFile(f)
+		p.ctx.SetFileSource(p.sources.Get(p.fset.Position(f.Pos()).Filename))
+		p.checkFile(pkg.PkgPath, f)
 	}
 }
 
-func (p *program) checkFile(f *ast.File) {
+func (p *program) checkFile(pkgPath string, f *ast.File) {
 	warnings := make([][]linter.Warning, len(p.checkers))
 
 	var wg sync.WaitGroup
@@ -155,6 +170,11 @@ func (p *program) checkFile(f *ast.File) {
 	for i, c := range p.checkers {
 		for _, warn := range warnings[i] {
 			p.foundIssues = true
+			p.findings.add(c.Info, pkgPath)
+			if p.format != "text" {
+				p.reported = append(p.reported, reportedWarning{info: c.Info, pkgPath: pkgPath, warn: warn})
+				continue
+			}
 			loc := p.ctx.FileSet.Position(warn.Node.Pos()).String()
 			if p.shorterErrLocation {
 				loc = p.shortenLocation(loc)
@@ -165,6 +185,23 @@ func (p *program) checkFile(f *ast.File) {
 
 }
 
+// packageGoVersion returns the Go version that should be used for pkg.
+// Version specified with -go flag has the highest priority.
+// If it's not specified, the module go directive is used.
+func (p *program) packageGoVersion(pkg *packages.Package) linter.GoVersion {
+	if !p.goVersion.IsAny() || pkg.Module == nil {
+		return p.goVersion
+	}
+	v, err := linter.ParseGoVersion(pkg.Module.GoVersion)
+	if err != nil {
+		if p.verbose {
+			log.Printf("\tdebug: %s: %v", pkg.String(), err)
+		}
+		return p.goVersion
+	}
+	return v
+}
+
 func (p *program) initCheckers() error {
 	parseKeys := func(keys []string, byName, byTag map[string]bool) {
 		for _, key := range keys {
@@ -239,9 +276,10 @@ func (p *program) initCheckers() error {
 }
 
 func (p *program) loadProgram() error {
-	sizes := types.SizesFor("gc", runtime.GOARCH)
+	// Use the target platform sizes; build.Default respects GOARCH env var.
+	sizes := types.SizesFor("gc", build.Default.GOARCH)
 	if sizes == nil {
-		return fmt.Errorf("can't find sizes info for %s", runtime.GOARCH)
+		return fmt.Errorf("can't find sizes info for %s", build.Default.GOARCH)
 	}
 
 	p.fset = token.NewFileSet()
@@ -252,11 +290,13 @@ func (p *program) loadProgram() error {
 		packages.NeedTypes |
 		packages.NeedSyntax |
 		packages.NeedTypesInfo |
-		packages.NeedTypesSizes
+		packages.NeedTypesSizes |
+		packages.NeedModule
 	cfg := packages.Config{
-		Mode:  mode,
-		Tests: true,
-		Fset:  p.fset,
+		Mode:      mode,
+		Tests:     true,
+		Fset:      p.fset,
+		ParseFile: p.sources.ParseFile,
 	}
 	pkgs, err := loadPackages(&cfg, p.packages)
 	if err != nil {
@@ -343,6 +383,10 @@ func (p *program) parseArgs() error {
 		`comma-separated list of enabled checkers. Can include #tags`)
 	disable := flag.String("disable", "",
 		`comma-separated list of checkers to be disabled. Can include #tags`)
+	flag.StringVar(&p.format, "format", "text",
+		`output format: text, gerrit (Gerrit robot comments JSON) or idea (JetBrains inspections XML)`)
+	flag.StringVar(&p.metricsFile, "metricsFile", "",
+		`if not empty, write findings summary in OpenMetrics text format to this file`)
 	flag.IntVar(&p.exitCode, "exitCode", 1,
 		`exit code to be used when lint issues are found`)
 	flag.BoolVar(&p.checkTests, "checkTests", true,
@@ -353,9 +397,23 @@ func (p *program) parseArgs() error {
 		`whether to use colored output`)
 	flag.BoolVar(&p.verbose, "v", false,
 		`whether to print output useful during linter debugging`)
+	goVersion := flag.String("go", "",
+		`target Go version, like 1.20; if empty, the module go directive is used`)
 
 	flag.Parse()
 
+	v, err := linter.ParseGoVersion(*goVersion)
+	if err != nil {
+		return err
+	}
+	p.goVersion = v
+
+	switch p.format {
+	case "text", "gerrit", "idea":
+	default:
+		return fmt.Errorf("unknown -format %q", p.format)
+	}
+
 	p.packages = flag.Args()
 	p.filters.enable = strings.Split(*enable, ",")
 	p.filters.disable = strings.Split(*disable, ",")
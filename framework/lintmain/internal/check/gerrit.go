@@ -0,0 +1,106 @@
+package check
+
+import (
+	"encoding/json"
+	"go/token"
+	"io"
+	"time"
+)
+
+// This file implements -format=gerrit output.
+// It's a ReviewInput object with robot comments that can be posted
+// to the Gerrit "set review" REST endpoint as is.
+// See https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#robot-comment-input.
+
+type gerritReview struct {
+	Tag           string                          `json:"tag"`
+	RobotComments map[string][]gerritRobotComment `json:"robot_comments"`
+}
+
+type gerritRobotComment struct {
+	Path           string                `json:"path"`
+	Line           int                   `json:"line"`
+	Range          gerritRange           `json:"range"`
+	Message        string                `json:"message"`
+	RobotID        string                `json:"robot_id"`
+	RobotRunID     string                `json:"robot_run_id"`
+	Properties     map[string]string     `json:"properties"`
+	FixSuggestions []gerritFixSuggestion `json:"fix_suggestions,omitempty"`
+}
+
+type gerritFixSuggestion struct {
+	Description  string              `json:"description"`
+	Replacements []gerritReplacement `json:"replacements"`
+}
+
+type gerritReplacement struct {
+	Path        string      `json:"path"`
+	Range       gerritRange `json:"range"`
+	Replacement string      `json:"replacement"`
+}
+
+// gerritRange uses 1-based lines and 0-based characters.
+// Characters are counted in UTF-16 code units, like Gerrit does.
+type gerritRange struct {
+	StartLine      int `json:"start_line"`
+	StartCharacter int `json:"start_character"`
+	EndLine        int `json:"end_line"`
+	EndCharacter   int `json:"end_character"`
+}
+
+// printGerritReport writes the collected warnings as Gerrit review JSON.
+//
+// File paths are relative to the working directory,
+// so the linter is expected to be run from the repository root.
+func (p *program) printGerritReport(w io.Writer) error {
+	review := p.gerritReview(time.Now().UTC().Format("20060102T150405Z"))
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(review)
+}
+
+func (p *program) gerritReview(runID string) gerritReview {
+	review := gerritReview{
+		Tag:           "autogenerated:gocritic",
+		RobotComments: make(map[string][]gerritRobotComment),
+	}
+	sources := make(sourceCache)
+	newRange := func(from, to token.Pos) gerritRange {
+		start := p.fset.Position(from)
+		end := p.fset.Position(to)
+		src := sources.get(start.Filename)
+		return gerritRange{
+			StartLine:      start.Line,
+			StartCharacter: utf16Column(src, start),
+			EndLine:        end.Line,
+			EndCharacter:   utf16Column(src, end),
+		}
+	}
+
+	for _, r := range p.reported {
+		checker := r.info.Name
+		path := p.relativePath(p.fset.Position(r.warn.Node.Pos()).Filename)
+		comment := gerritRobotComment{
+			Path:       path,
+			Line:       p.fset.Position(r.warn.Node.Pos()).Line,
+			Range:      newRange(r.warn.Node.Pos(), r.warn.Node.End()),
+			Message:    checker + ": " + r.warn.Text,
+			RobotID:    "gocritic",
+			RobotRunID: runID,
+			Properties: map[string]string{"checker": checker},
+		}
+		if r.warn.HasQuickFix() {
+			comment.FixSuggestions = []gerritFixSuggestion{{
+				Description: checker + ": apply suggested fix",
+				Replacements: []gerritReplacement{{
+					Path:        path,
+					Range:       newRange(r.warn.Suggestion.From, r.warn.Suggestion.To),
+					Replacement: string(r.warn.Suggestion.Replacement),
+				}},
+			}}
+		}
+		review.RobotComments[path] = append(review.RobotComments[path], comment)
+	}
+	return review
+}
@@ -0,0 +1,101 @@
+package check
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGerritReview(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gocritic-gerrit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := "package p\n\nvar s = \"日本\" + \"x\"\n"
+	filename := filepath.Join(dir, "pkg", "p.go")
+	if err := os.Mkdir(filepath.Dir(filename), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	concat := f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0].(*ast.BinaryExpr)
+
+	p := &program{
+		fset:    fset,
+		workDir: addTrailingSlash(dir),
+		reported: []reportedWarning{
+			{
+				info: &linter.CheckerInfo{Name: "stringConcatSimplify"},
+				warn: linter.Warning{Node: concat.Y, Text: "simplify"},
+			},
+			{
+				info: &linter.CheckerInfo{Name: "stringConcat"},
+				warn: linter.Warning{
+					Node: concat,
+					Text: "use a single literal",
+					Suggestion: linter.QuickFix{
+						From:        concat.Pos(),
+						To:          concat.End(),
+						Replacement: []byte(`"日本x"`),
+					},
+				},
+			},
+		},
+	}
+
+	// "日本" takes 6 bytes, but 2 UTF-16 code units.
+	rangeY := gerritRange{StartLine: 3, StartCharacter: 15, EndLine: 3, EndCharacter: 18}
+	rangeConcat := gerritRange{StartLine: 3, StartCharacter: 8, EndLine: 3, EndCharacter: 18}
+	want := gerritReview{
+		Tag: "autogenerated:gocritic",
+		RobotComments: map[string][]gerritRobotComment{
+			"pkg/p.go": {
+				{
+					Path:       "pkg/p.go",
+					Line:       3,
+					Range:      rangeY,
+					Message:    "stringConcatSimplify: simplify",
+					RobotID:    "gocritic",
+					RobotRunID: "run1",
+					Properties: map[string]string{"checker": "stringConcatSimplify"},
+				},
+				{
+					Path:       "pkg/p.go",
+					Line:       3,
+					Range:      rangeConcat,
+					Message:    "stringConcat: use a single literal",
+					RobotID:    "gocritic",
+					RobotRunID: "run1",
+					Properties: map[string]string{"checker": "stringConcat"},
+					FixSuggestions: []gerritFixSuggestion{{
+						Description: "stringConcat: apply suggested fix",
+						Replacements: []gerritReplacement{{
+							Path:        "pkg/p.go",
+							Range:       rangeConcat,
+							Replacement: `"日本x"`,
+						}},
+					}},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, p.gerritReview("run1")); diff != "" {
+		t.Errorf("review mismatch (-want +have):\n%s", diff)
+	}
+}
@@ -0,0 +1,119 @@
+package check
+
+import (
+	"encoding/xml"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// This file implements -format=idea output.
+// It's an offline inspection results XML that is produced by the
+// JetBrains IDEs inspect.sh tool. To view the results in GoLand,
+// save the output to an empty directory and open it with
+// "Code | Analyze Code | View Offline Inspection Results".
+
+type ideaProblems struct {
+	XMLName     xml.Name      `xml:"problems"`
+	IsLocalTool bool          `xml:"is_local_tool,attr"`
+	Problems    []ideaProblem `xml:"problem"`
+}
+
+type ideaProblem struct {
+	File               string           `xml:"file"`
+	Line               int              `xml:"line"`
+	Module             string           `xml:"module"`
+	Package            string           `xml:"package"`
+	EntryPoint         ideaEntryPoint   `xml:"entry_point"`
+	ProblemClass       ideaProblemClass `xml:"problem_class"`
+	Description        string           `xml:"description"`
+	HighlightedElement string           `xml:"highlighted_element,omitempty"`
+	Language           string           `xml:"language"`
+	Offset             int              `xml:"offset"`
+	Length             int              `xml:"length"`
+}
+
+type ideaEntryPoint struct {
+	Type   string `xml:"TYPE,attr"`
+	FQName string `xml:"FQNAME,attr"`
+}
+
+type ideaProblemClass struct {
+	ID           string `xml:"id,attr"`
+	Severity     string `xml:"severity,attr"`
+	AttributeKey string `xml:"attribute_key,attr"`
+	Text         string `xml:",chardata"`
+}
+
+// ideaSeverities maps checker severities to the IDE severities
+// and their highlighting attributes.
+var ideaSeverities = map[string]struct{ severity, attributeKey string }{
+	"error":   {"ERROR", "ERRORS_ATTRIBUTES"},
+	"warning": {"WARNING", "WARNING_ATTRIBUTES"},
+	"info":    {"WEAK WARNING", "INFO_ATTRIBUTES"},
+}
+
+// printIdeaReport writes the collected warnings as inspection results XML.
+func (p *program) printIdeaReport(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(p.ideaProblems()); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (p *program) ideaProblems() ideaProblems {
+	module := filepath.Base(strings.TrimSuffix(p.workDir, string(filepath.Separator)))
+	sources := make(sourceCache)
+	problems := ideaProblems{IsLocalTool: true}
+	for _, r := range p.reported {
+		start := p.fset.Position(r.warn.Node.Pos())
+		end := p.fset.Position(r.warn.Node.End())
+		src := sources.get(start.Filename)
+		file := p.ideaFileURL(start.Filename)
+		severity := ideaSeverities[checkerSeverity(r.info)]
+
+		problem := ideaProblem{
+			File:       file,
+			Line:       start.Line,
+			Module:     module,
+			Package:    r.pkgPath,
+			EntryPoint: ideaEntryPoint{Type: "file", FQName: file},
+			ProblemClass: ideaProblemClass{
+				ID:           r.info.Name,
+				Severity:     severity.severity,
+				AttributeKey: severity.attributeKey,
+				Text:         r.info.Summary,
+			},
+			Description: r.info.Name + ": " + r.warn.Text,
+			Language:    "go",
+			Offset:      utf16Column(src, start),
+		}
+		if src != nil && end.Offset <= len(src) {
+			text := src[start.Offset:end.Offset]
+			problem.Length = utf16Len(text)
+			if !strings.Contains(string(text), "\n") {
+				problem.HighlightedElement = string(text)
+			}
+		} else {
+			problem.Length = end.Offset - start.Offset
+		}
+		problems.Problems = append(problems.Problems, problem)
+	}
+	return problems
+}
+
+// ideaFileURL returns filename URL relative to the project directory.
+// The working directory is assumed to be the project root.
+func (p *program) ideaFileURL(filename string) string {
+	path := p.relativePath(filename)
+	if filepath.IsAbs(filepath.FromSlash(path)) {
+		return "file://" + path
+	}
+	return "file://$PROJECT_DIR$/" + path
+}
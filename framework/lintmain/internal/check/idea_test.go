@@ -0,0 +1,94 @@
+package check
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIdeaReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gocritic-idea")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	projectDir := filepath.Join(dir, "project")
+	if err := os.Mkdir(projectDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	src := "package p\n\nvar s = \"日本\" + \"x\"\n"
+	filename := filepath.Join(projectDir, "p.go")
+	if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	concat := f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0].(*ast.BinaryExpr)
+
+	p := &program{
+		fset:    fset,
+		workDir: addTrailingSlash(projectDir),
+		reported: []reportedWarning{{
+			info: &linter.CheckerInfo{
+				Name:    "stringConcat",
+				Tags:    []string{"style"},
+				Summary: "Detects <concatenation> of string literals",
+			},
+			pkgPath: "example.com/p",
+			warn:    linter.Warning{Node: concat, Text: "use a single literal"},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := p.printIdeaReport(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<problems is_local_tool="true">
+  <problem>
+    <file>file://$PROJECT_DIR$/p.go</file>
+    <line>3</line>
+    <module>project</module>
+    <package>example.com/p</package>
+    <entry_point TYPE="file" FQNAME="file://$PROJECT_DIR$/p.go"></entry_point>
+    <problem_class id="stringConcat" severity="WEAK WARNING" attribute_key="INFO_ATTRIBUTES">Detects &lt;concatenation&gt; of string literals</problem_class>
+    <description>stringConcat: use a single literal</description>
+    <highlighted_element>&#34;日本&#34; + &#34;x&#34;</highlighted_element>
+    <language>go</language>
+    <offset>8</offset>
+    <length>10</length>
+  </problem>
+</problems>
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("report mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestIdeaFileURL(t *testing.T) {
+	p := &program{workDir: "/home/queen/project/"}
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"/home/queen/project/pkg/a.go", "file://$PROJECT_DIR$/pkg/a.go"},
+		{"/home/queen/go/src/b.go", "file:///home/queen/go/src/b.go"},
+	}
+	for _, test := range tests {
+		if have := p.ideaFileURL(test.filename); have != test.want {
+			t.Errorf("ideaFileURL(%q):\nhave: %q\nwant: %q", test.filename, have, test.want)
+		}
+	}
+}
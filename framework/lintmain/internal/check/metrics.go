@@ -0,0 +1,150 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+// This file implements -metricsFile output.
+// Metrics are written in the OpenMetrics text format that is also
+// accepted by the Prometheus node_exporter textfile collector.
+// See https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md.
+
+// findingsCounter counts the reported warnings.
+type findingsCounter struct {
+	byChecker map[string]int
+	byPackage map[string]int
+}
+
+func (c *findingsCounter) add(info *linter.CheckerInfo, pkgPath string) {
+	if c.byChecker == nil {
+		c.byChecker = make(map[string]int)
+		c.byPackage = make(map[string]int)
+	}
+	c.byChecker[info.Name]++
+	c.byPackage[pkgPath]++
+}
+
+// checkerSeverity returns a severity of the checker warnings.
+//
+// Checkers don't have severities on their own, so it's derived from tags:
+// diagnostic checkers find bugs, performance checkers find inefficiencies
+// and the rest are about the code style.
+func checkerSeverity(info *linter.CheckerInfo) string {
+	switch {
+	case info.HasTag("diagnostic"):
+		return "error"
+	case info.HasTag("performance"):
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// writeMetrics writes the findings summary to the -metricsFile, if it's set.
+//
+// The file is replaced atomically, so the scrapers never see
+// a partially written file.
+func (p *program) writeMetrics() error {
+	if p.metricsFile == "" {
+		return nil
+	}
+	var buf bytes.Buffer
+	p.printMetrics(&buf)
+
+	dir := filepath.Dir(p.metricsFile)
+	tmp, err := ioutil.TempFile(dir, filepath.Base(p.metricsFile)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // Fails after the successful rename
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// TempFile creates files that are only readable by the owner.
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p.metricsFile)
+}
+
+// printMetrics writes the findings summary to w.
+// Enabled checkers and checked packages without findings are
+// reported with zero values, so they don't disappear from the graphs.
+func (p *program) printMetrics(w io.Writer) {
+	bySeverity := map[string]int{"error": 0, "warning": 0, "info": 0}
+	type checkerFindings struct {
+		name     string
+		severity string
+		count    int
+	}
+	var checkers []checkerFindings
+	for _, c := range p.checkers {
+		count := p.findings.byChecker[c.Info.Name]
+		severity := checkerSeverity(c.Info)
+		bySeverity[severity] += count
+		checkers = append(checkers, checkerFindings{c.Info.Name, severity, count})
+	}
+	sort.Slice(checkers, func(i, j int) bool {
+		return checkers[i].name < checkers[j].name
+	})
+
+	byPackage := make(map[string]int)
+	for _, pkg := range p.loadedPackages {
+		byPackage[pkg.PkgPath] = 0
+	}
+	for pkgPath, count := range p.findings.byPackage {
+		byPackage[pkgPath] = count
+	}
+
+	fmt.Fprintln(w, "# HELP gocritic_findings Number of warnings reported by the checker.")
+	fmt.Fprintln(w, "# TYPE gocritic_findings gauge")
+	for _, c := range checkers {
+		fmt.Fprintf(w, "gocritic_findings{checker=%s,severity=%s} %d\n",
+			quoteLabel(c.name), quoteLabel(c.severity), c.count)
+	}
+
+	fmt.Fprintln(w, "# HELP gocritic_severity_findings Number of warnings with the severity.")
+	fmt.Fprintln(w, "# TYPE gocritic_severity_findings gauge")
+	for _, severity := range sortedKeys(bySeverity) {
+		fmt.Fprintf(w, "gocritic_severity_findings{severity=%s} %d\n",
+			quoteLabel(severity), bySeverity[severity])
+	}
+
+	fmt.Fprintln(w, "# HELP gocritic_package_findings Number of warnings in the package.")
+	fmt.Fprintln(w, "# TYPE gocritic_package_findings gauge")
+	for _, pkgPath := range sortedKeys(byPackage) {
+		fmt.Fprintf(w, "gocritic_package_findings{package=%s} %d\n",
+			quoteLabel(pkgPath), byPackage[pkgPath])
+	}
+
+	fmt.Fprintln(w, "# EOF")
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quoteLabel returns s as a quoted label value.
+func quoteLabel(s string) string {
+	return `"` + labelReplacer.Replace(s) + `"`
+}
@@ -0,0 +1,93 @@
+package check
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/tools/go/packages"
+)
+
+func newMetricsTestProgram() *program {
+	infoList := []*linter.CheckerInfo{
+		{Name: "sloppyLen", Tags: []string{"style"}},
+		{Name: "appendAssign", Tags: []string{"diagnostic"}},
+		{Name: "hugeParam", Tags: []string{"performance"}},
+	}
+	p := &program{
+		loadedPackages: []*packages.Package{
+			{PkgPath: "example.com/b"},
+			{PkgPath: `example.com/"a"`},
+		},
+	}
+	for _, info := range infoList {
+		p.checkers = append(p.checkers, &linter.Checker{Info: info})
+	}
+	p.findings.add(infoList[0], "example.com/b")
+	p.findings.add(infoList[0], "example.com/b")
+	p.findings.add(infoList[1], "example.com/b")
+	return p
+}
+
+func TestPrintMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	newMetricsTestProgram().printMetrics(&buf)
+
+	want := `# HELP gocritic_findings Number of warnings reported by the checker.
+# TYPE gocritic_findings gauge
+gocritic_findings{checker="appendAssign",severity="error"} 1
+gocritic_findings{checker="hugeParam",severity="warning"} 0
+gocritic_findings{checker="sloppyLen",severity="info"} 2
+# HELP gocritic_severity_findings Number of warnings with the severity.
+# TYPE gocritic_severity_findings gauge
+gocritic_severity_findings{severity="error"} 1
+gocritic_severity_findings{severity="info"} 2
+gocritic_severity_findings{severity="warning"} 0
+# HELP gocritic_package_findings Number of warnings in the package.
+# TYPE gocritic_package_findings gauge
+gocritic_package_findings{package="example.com/\"a\""} 0
+gocritic_package_findings{package="example.com/b"} 3
+# EOF
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("metrics mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestWriteMetrics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gocritic-metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := newMetricsTestProgram()
+	p.metricsFile = filepath.Join(dir, "gocritic.prom")
+	if err := ioutil.WriteFile(p.metricsFile, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.writeMetrics(); err != nil {
+		t.Fatal(err)
+	}
+
+	var want bytes.Buffer
+	p.printMetrics(&want)
+	have, err := ioutil.ReadFile(p.metricsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(have) != want.String() {
+		t.Errorf("unexpected file contents:\n%s", have)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("temporary files are left: %d files in dir", len(files))
+	}
+}
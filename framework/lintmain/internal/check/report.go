@@ -0,0 +1,84 @@
+package check
+
+import (
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+// reportedWarning is a warning that is printed after all
+// packages are checked.
+type reportedWarning struct {
+	info    *linter.CheckerInfo
+	pkgPath string
+	warn    linter.Warning
+}
+
+// printReport prints the collected warnings in the selected format.
+// Text format warnings are printed as soon as they're found.
+func (p *program) printReport() error {
+	switch p.format {
+	case "gerrit":
+		return p.printGerritReport(os.Stdout)
+	case "idea":
+		return p.printIdeaReport(os.Stdout)
+	default:
+		return nil
+	}
+}
+
+// relativePath returns filename relative to the working directory,
+// with forward slashes. Files outside of it keep the absolute path.
+func (p *program) relativePath(filename string) string {
+	if p.workDir != "" {
+		rel, err := filepath.Rel(p.workDir, filename)
+		if err == nil && !strings.HasPrefix(rel, "..") {
+			filename = rel
+		}
+	}
+	return filepath.ToSlash(filename)
+}
+
+// sourceCache maps file names to their contents.
+type sourceCache map[string][]byte
+
+// get returns filename contents.
+// Read errors are not fatal, nil is returned in this case,
+// so the positions are reported in bytes.
+func (c sourceCache) get(filename string) []byte {
+	src, ok := c[filename]
+	if !ok {
+		src, _ = ioutil.ReadFile(filename)
+		c[filename] = src
+	}
+	return src
+}
+
+// utf16Column returns 0-based pos column in UTF-16 code units.
+// If src doesn't match pos, byte column is returned.
+func utf16Column(src []byte, pos token.Position) int {
+	lineStart := pos.Offset - (pos.Column - 1)
+	if lineStart < 0 || pos.Offset > len(src) {
+		return pos.Column - 1
+	}
+	return utf16Len(src[lineStart:pos.Offset])
+}
+
+// utf16Len returns the length of text in UTF-16 code units.
+func utf16Len(text []byte) int {
+	n := 0
+	for _, r := range string(text) {
+		if r == utf8.RuneError {
+			n++
+			continue
+		}
+		n += len(utf16.Encode([]rune{r}))
+	}
+	return n
+}
@@ -0,0 +1,22 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestRelativePath(t *testing.T) {
+	p := &program{workDir: "/home/queen/project/"}
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"/home/queen/project/main.go", "main.go"},
+		{"/home/queen/project/pkg/a.go", "pkg/a.go"},
+		{"/home/queen/go/src/b.go", "/home/queen/go/src/b.go"},
+	}
+	for _, test := range tests {
+		if have := p.relativePath(test.filename); have != test.want {
+			t.Errorf("relativePath(%q):\nhave: %q\nwant: %q", test.filename, have, test.want)
+		}
+	}
+}
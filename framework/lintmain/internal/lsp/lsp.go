@@ -0,0 +1,371 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-critic/go-critic/gocritic"
+	"golang.org/x/tools/go/packages"
+)
+
+// Main implements sub-command entry point.
+func Main() {
+	enable := flag.String("enable", "",
+		`comma-separated list of enabled checkers. Can include #tags. If empty, the default checkers are used`)
+	disable := flag.String("disable", "",
+		`comma-separated list of checkers to be disabled. Can include #tags`)
+	delay := flag.Duration("delay", 300*time.Millisecond,
+		`how long to wait after the last document change before re-analysis`)
+	flag.Parse()
+
+	s := newServer(os.Stdout)
+	s.delay = *delay
+	infoList, err := gocritic.SelectCheckers(gocritic.Config{
+		Enable:  []string{*enable},
+		Disable: []string{*disable},
+	})
+	if err != nil {
+		log.Fatalf("lsp: %v", err)
+	}
+	s.infoList = infoList
+	if err := s.serve(os.Stdin); err != nil {
+		log.Fatalf("lsp: %v", err)
+	}
+}
+
+// server is a Language Server that publishes checkers warnings
+// as diagnostics for opened files and their fixes as code actions.
+//
+// Every document change triggers the re-analysis of the
+// changed document only.
+type server struct {
+	delay    time.Duration
+	infoList []*linter.CheckerInfo
+
+	outMu sync.Mutex
+	out   io.Writer
+
+	// analyzeMu serializes the analysis runs.
+	analyzeMu sync.Mutex
+
+	mu       sync.Mutex
+	docs     map[string]string
+	timers   map[string]*time.Timer
+	fixes    map[string][]codeAction
+	shutdown bool
+}
+
+func newServer(out io.Writer) *server {
+	return &server{
+		out:    out,
+		docs:   make(map[string]string),
+		timers: make(map[string]*time.Timer),
+		fixes:  make(map[string][]codeAction),
+	}
+}
+
+// serve handles messages from r until the exit notification
+// is received or r is closed.
+func (s *server) serve(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		msg, err := readMessage(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+		s.handle(msg)
+	}
+}
+
+func (s *server) handle(msg *message) {
+	var result interface{}
+	var err *responseError
+
+	switch msg.Method {
+	case "initialize":
+		result = map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": map[string]interface{}{
+					"openClose": true,
+					"change":    1, // Full document sync
+					"save":      true,
+				},
+				"codeActionProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "gocritic"},
+		}
+	case "shutdown":
+		s.mu.Lock()
+		s.shutdown = true
+		for _, t := range s.timers {
+			t.Stop()
+		}
+		s.mu.Unlock()
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if json.Unmarshal(msg.Params, &params) == nil {
+			s.setDoc(params.TextDocument.URI, params.TextDocument.Text)
+			s.schedule(params.TextDocument.URI, 0)
+		}
+	case "textDocument/didChange":
+		var params didChangeParams
+		if json.Unmarshal(msg.Params, &params) == nil && len(params.ContentChanges) != 0 {
+			changes := params.ContentChanges
+			s.setDoc(params.TextDocument.URI, changes[len(changes)-1].Text)
+			s.schedule(params.TextDocument.URI, s.delay)
+		}
+	case "textDocument/didSave":
+		var params didSaveParams
+		if json.Unmarshal(msg.Params, &params) == nil {
+			s.schedule(params.TextDocument.URI, 0)
+		}
+	case "textDocument/didClose":
+		var params didCloseParams
+		if json.Unmarshal(msg.Params, &params) == nil {
+			s.closeDoc(params.TextDocument.URI)
+		}
+	case "textDocument/codeAction":
+		var params codeActionParams
+		if e := json.Unmarshal(msg.Params, &params); e != nil {
+			err = &responseError{Code: codeInvalidParams, Message: e.Error()}
+			break
+		}
+		result = s.codeActions(params)
+	default:
+		if msg.ID != nil {
+			err = &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+		}
+	}
+
+	if msg.ID != nil {
+		// Requests always get a response, notifications never do.
+		if result == nil && err == nil {
+			result = json.RawMessage("null")
+		}
+		s.send(&message{ID: msg.ID, Result: result, Error: err})
+	}
+}
+
+func (s *server) send(msg *message) {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+	if err := writeMessage(s.out, msg); err != nil {
+		log.Printf("lsp: write message: %v", err)
+	}
+}
+
+func (s *server) setDoc(uri, text string) {
+	s.mu.Lock()
+	s.docs[uri] = text
+	s.mu.Unlock()
+}
+
+func (s *server) closeDoc(uri string) {
+	s.mu.Lock()
+	delete(s.docs, uri)
+	delete(s.fixes, uri)
+	if t := s.timers[uri]; t != nil {
+		t.Stop()
+		delete(s.timers, uri)
+	}
+	s.mu.Unlock()
+
+	// Clear the diagnostics of the closed document.
+	s.publish(uri, nil)
+}
+
+// schedule runs the analysis of uri document after the delay.
+// Pending analysis of the same document is cancelled.
+func (s *server) schedule(uri string, delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shutdown {
+		return
+	}
+	if t := s.timers[uri]; t != nil {
+		t.Stop()
+	}
+	s.timers[uri] = time.AfterFunc(delay, func() {
+		s.analyze(uri)
+	})
+}
+
+func (s *server) codeActions(params codeActionParams) []codeAction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	actions := []codeAction{}
+	for _, action := range s.fixes[params.TextDocument.URI] {
+		if rangesOverlap(action.Diagnostics[0].Range, params.Range) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+func (s *server) publish(uri string, diags []diagnostic) {
+	if diags == nil {
+		diags = []diagnostic{}
+	}
+	params, err := json.Marshal(publishDiagnosticsParams{URI: uri, Diagnostics: diags})
+	if err != nil {
+		log.Printf("lsp: %v", err)
+		return
+	}
+	s.send(&message{Method: "textDocument/publishDiagnostics", Params: params})
+}
+
+// analyze runs checkers over the uri document and publishes the results.
+func (s *server) analyze(uri string) {
+	s.analyzeMu.Lock()
+	defer s.analyzeMu.Unlock()
+
+	s.mu.Lock()
+	content, ok := s.docs[uri]
+	overlay := make(map[string][]byte, len(s.docs))
+	for docURI, text := range s.docs {
+		if path, err := uriToPath(docURI); err == nil {
+			overlay[path] = []byte(text)
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		return // Closed while waiting
+	}
+
+	path, err := uriToPath(uri)
+	if err != nil {
+		log.Printf("lsp: %v", err)
+		return
+	}
+	diags, actions, err := s.check(uri, path, content, overlay)
+	if err != nil {
+		log.Printf("lsp: check %s: %v", path, err)
+		return
+	}
+
+	s.mu.Lock()
+	if _, ok := s.docs[uri]; !ok || s.docs[uri] != content {
+		// Document was closed or changed during the analysis,
+		// the results are outdated.
+		s.mu.Unlock()
+		return
+	}
+	s.fixes[uri] = actions
+	s.mu.Unlock()
+	s.publish(uri, diags)
+}
+
+// check loads the package of the path file and runs checkers over it.
+func (s *server) check(uri, path, content string, overlay map[string][]byte) ([]diagnostic, []codeAction, error) {
+	sizes := types.SizesFor("gc", build.Default.GOARCH)
+	if sizes == nil {
+		return nil, nil, fmt.Errorf("can't find sizes info for %s", build.Default.GOARCH)
+	}
+	fset := token.NewFileSet()
+	var sources linter.FileSources
+	cfg := packages.Config{
+		Mode: packages.NeedName |
+			packages.NeedFiles |
+			packages.NeedCompiledGoFiles |
+			packages.NeedImports |
+			packages.NeedTypes |
+			packages.NeedSyntax |
+			packages.NeedTypesInfo |
+			packages.NeedTypesSizes |
+			packages.NeedModule,
+		Dir:       filepath.Dir(path),
+		Tests:     strings.HasSuffix(path, "_test.go"),
+		Fset:      fset,
+		Overlay:   overlay,
+		ParseFile: sources.ParseFile,
+	}
+	pkgs, err := packages.Load(&cfg, "file="+path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diags := []diagnostic{}
+	var actions []codeAction
+	seen := make(map[string]bool)
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) != 0 || pkg.TypesInfo == nil {
+			continue // Don't report warnings for broken code
+		}
+		ctx := linter.NewContext(fset, sizes)
+		ctx.SetPackageInfo(pkg.TypesInfo, pkg.Types)
+		ctx.GoVersion = gocritic.PackageGoVersion(pkg, linter.GoVersion{})
+		for _, f := range pkg.Syntax {
+			if fset.Position(f.Pos()).Filename != path {
+				continue
+			}
+			ctx.SetFileInfo(filepath.Base(path), f)
+			ctx.SetFileSource(sources.Get(path))
+			for _, info := range s.infoList {
+				c := linter.NewChecker(ctx, info)
+				warnings, err := gocritic.CheckFile(c, f)
+				if err != nil {
+					// A single checker can't stop the server.
+					log.Printf("lsp: %v", err)
+					continue
+				}
+				for _, warn := range warnings {
+					d := diagnostic{
+						Range:    s.textRange(fset, content, warn.Node.Pos(), warn.Node.End()),
+						Severity: severityWarning,
+						Code:     info.Name,
+						Source:   "gocritic",
+						Message:  warn.Text,
+					}
+					key := fmt.Sprintf("%v:%s:%s", d.Range, d.Code, d.Message)
+					if seen[key] {
+						continue // The same file in test and non-test packages
+					}
+					seen[key] = true
+					diags = append(diags, d)
+					if warn.HasQuickFix() {
+						edit := textEdit{
+							Range:   s.textRange(fset, content, warn.Suggestion.From, warn.Suggestion.To),
+							NewText: string(warn.Suggestion.Replacement),
+						}
+						actions = append(actions, codeAction{
+							Title:       info.Name + ": apply suggested fix",
+							Kind:        "quickfix",
+							Diagnostics: []diagnostic{d},
+							Edit:        workspaceEdit{Changes: map[string][]textEdit{uri: {edit}}},
+						})
+					}
+				}
+			}
+		}
+	}
+	return diags, actions, nil
+}
+
+func (s *server) textRange(fset *token.FileSet, content string, from, to token.Pos) textRange {
+	start := fset.Position(from)
+	end := fset.Position(to)
+	return textRange{
+		Start: lspPosition(content, start.Line, start.Column),
+		End:   lspPosition(content, end.Line, end.Column),
+	}
+}
@@ -0,0 +1,125 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMessageFraming(t *testing.T) {
+	var buf bytes.Buffer
+	id := json.RawMessage(`1`)
+	if err := writeMessage(&buf, &message{ID: &id, Method: "initialize"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "Content-Length: ") {
+		t.Fatalf("missing Content-Length header: %q", buf.String())
+	}
+	msg, err := readMessage(bufio.NewReader(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Method != "initialize" || string(*msg.ID) != "1" {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+}
+
+func TestLSPPosition(t *testing.T) {
+	content := "package p\n\nvar s = \"日本\" + x\n"
+	tests := []struct {
+		line, column int
+		want         position
+	}{
+		{1, 1, position{0, 0}},
+		{1, 9, position{0, 8}},
+		{3, 5, position{2, 4}},
+		// "日本" takes 6 bytes, but 2 UTF-16 code units.
+		{3, 20, position{2, 15}},
+	}
+	for _, test := range tests {
+		have := lspPosition(content, test.line, test.column)
+		if have != test.want {
+			t.Errorf("lspPosition(%d, %d):\nhave: %+v\nwant: %+v",
+				test.line, test.column, have, test.want)
+		}
+	}
+}
+
+func TestURIToPath(t *testing.T) {
+	path, err := uriToPath("file:///home/user/my%20project/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/home/user/my project/main.go" {
+		t.Errorf("unexpected path: %q", path)
+	}
+	if _, err := uriToPath("untitled:Untitled-1"); err == nil {
+		t.Errorf("expected an error for non-file URI")
+	}
+}
+
+func TestServerRequests(t *testing.T) {
+	var out bytes.Buffer
+	s := newServer(&out)
+	uri := "file:///tmp/p/p.go"
+	d := diagnostic{Range: textRange{Start: position{2, 0}, End: position{2, 10}}, Code: "sloppyLen"}
+	s.fixes[uri] = []codeAction{{Title: "fix", Diagnostics: []diagnostic{d}}}
+
+	var in bytes.Buffer
+	send := func(id, method string, params interface{}) {
+		data, err := json.Marshal(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := &message{Method: method, Params: data}
+		if id != "" {
+			rawID := json.RawMessage(id)
+			msg.ID = &rawID
+		}
+		if err := writeMessage(&in, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	send("1", "initialize", map[string]interface{}{})
+	send("2", "textDocument/codeAction", codeActionParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		Range:        textRange{Start: position{2, 4}, End: position{2, 4}},
+	})
+	send("3", "textDocument/codeAction", codeActionParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		Range:        textRange{Start: position{5, 0}, End: position{5, 0}},
+	})
+	send("4", "textDocument/hover", map[string]interface{}{})
+	send("", "exit", nil)
+	if err := s.serve(&in); err != nil {
+		t.Fatal(err)
+	}
+
+	r := bufio.NewReader(&out)
+	want := []string{
+		`{"jsonrpc":"2.0","id":1,"result":{"capabilities":{"codeActionProvider":true,"textDocumentSync":{"change":1,"openClose":true,"save":true}},"serverInfo":{"name":"gocritic"}}}`,
+		`{"jsonrpc":"2.0","id":2,"result":[{"title":"fix","kind":"","diagnostics":[{"range":{"start":{"line":2,"character":0},"end":{"line":2,"character":10}},"severity":0,"code":"sloppyLen","source":"","message":""}],"edit":{"changes":null}}]}`,
+		`{"jsonrpc":"2.0","id":3,"result":[]}`,
+		`{"jsonrpc":"2.0","id":4,"error":{"code":-32601,"message":"method not found: textDocument/hover"}}`,
+	}
+	for i, w := range want {
+		msg, err := readMessage(r)
+		if err != nil {
+			t.Fatalf("response %d: %v", i, err)
+		}
+		// Responses are re-encoded to get the same keys order.
+		var wantMsg message
+		if err := json.Unmarshal([]byte(w), &wantMsg); err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w, err := json.Marshal(wantMsg); err != nil || string(data) != string(w) {
+			t.Errorf("response %d:\nhave: %s\nwant: %s", i, data, w)
+		}
+	}
+}
@@ -0,0 +1,438 @@
+package serve
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-critic/go-critic/gocritic"
+)
+
+// Main implements sub-command entry point.
+func Main() {
+	addr := flag.String("addr", "localhost:8080",
+		`address to listen on`)
+	enable := flag.String("enable", "",
+		`comma-separated list of checkers enabled by default. Can include #tags. If empty, the default checkers are used`)
+	disable := flag.String("disable", "",
+		`comma-separated list of checkers that can't be enabled by requests. Can include #tags`)
+	var lim limits
+	flag.Int64Var(&lim.maxBodySize, "maxBodySize", 1<<20,
+		`max request body size in bytes`)
+	flag.IntVar(&lim.maxFiles, "maxFiles", 16,
+		`max number of files in a single request`)
+	flag.IntVar(&lim.maxConcurrent, "maxConcurrent", 4,
+		`max number of requests that are checked at the same time`)
+	flag.DurationVar(&lim.timeout, "timeout", 10*time.Second,
+		`max time to wait for a single request results`)
+	flag.Parse()
+
+	s, err := newServer([]string{*enable}, []string{*disable}, lim)
+	if err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+	log.Printf("listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, s); err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+}
+
+// limits restrict the resources that a single request can use.
+type limits struct {
+	maxBodySize   int64
+	maxFiles      int
+	maxConcurrent int
+	timeout       time.Duration
+}
+
+// server is an HTTP handler that runs checkers over the Go sources
+// sent by the clients and responds with the found warnings.
+//
+// Endpoints:
+//
+//	POST /check    - check sources described by checkRequest
+//	GET  /checkers - list checkers that can be enabled by requests
+type server struct {
+	mux    *http.ServeMux
+	limits limits
+
+	// available are checkers that can be enabled by requests.
+	available []*linter.CheckerInfo
+	// defaults are checkers used when request enables nothing.
+	defaults []string
+	// disable are checkers that can't be enabled by requests.
+	disable []string
+
+	// sem limits the number of concurrent checks.
+	sem chan struct{}
+}
+
+func newServer(enable, disable []string, lim limits) (*server, error) {
+	if lim.maxConcurrent < 1 {
+		return nil, errors.New("maxConcurrent must be positive")
+	}
+	available, err := gocritic.SelectCheckers(gocritic.Config{
+		Disable:   disable,
+		EnableAll: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defaultList, err := gocritic.SelectCheckers(gocritic.Config{
+		Enable:  enable,
+		Disable: disable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defaults := make([]string, len(defaultList))
+	for i, info := range defaultList {
+		defaults[i] = info.Name
+	}
+
+	s := &server{
+		mux:       http.NewServeMux(),
+		limits:    lim,
+		available: available,
+		defaults:  defaults,
+		disable:   disable,
+		sem:       make(chan struct{}, lim.maxConcurrent),
+	}
+	s.mux.HandleFunc("/check", s.handleCheck)
+	s.mux.HandleFunc("/checkers", s.handleCheckers)
+	return s, nil
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// checkRequest is a /check request body.
+type checkRequest struct {
+	// Source is a single file to check.
+	// It's a shorthand for Files with a single "main.go" file.
+	Source string `json:"source,omitempty"`
+
+	// Files maps file names to their contents.
+	// All files must belong to the same package.
+	Files map[string]string `json:"files,omitempty"`
+
+	// Enable and Disable select checkers by their names and #tags.
+	// If Enable is empty, the server default checkers are used.
+	Enable  []string `json:"enable,omitempty"`
+	Disable []string `json:"disable,omitempty"`
+
+	// GoVersion is a target Go version, like "1.20".
+	GoVersion string `json:"go,omitempty"`
+}
+
+// checkResponse is a /check response body.
+//
+// Warnings are only reported for the code without errors.
+type checkResponse struct {
+	Warnings []warning `json:"warnings"`
+	Errors   []string  `json:"errors,omitempty"`
+}
+
+type warning struct {
+	Checker string    `json:"checker"`
+	Message string    `json:"message"`
+	Pos     position  `json:"pos"`
+	End     position  `json:"end"`
+	Fix     *quickFix `json:"fix,omitempty"`
+}
+
+type quickFix struct {
+	Pos         position `json:"pos"`
+	End         position `json:"end"`
+	Replacement string   `json:"replacement"`
+}
+
+type position struct {
+	File   string `json:"file"`
+	Offset int    `json:"offset"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+type checkerInfo struct {
+	Name    string   `json:"name"`
+	Tags    []string `json:"tags"`
+	Summary string   `json:"summary"`
+	Default bool     `json:"default"`
+}
+
+func (s *server) handleCheckers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		httpError(w, http.StatusMethodNotAllowed, "only GET is allowed")
+		return
+	}
+	isDefault := make(map[string]bool, len(s.defaults))
+	for _, name := range s.defaults {
+		isDefault[name] = true
+	}
+	list := make([]checkerInfo, len(s.available))
+	for i, info := range s.available {
+		list[i] = checkerInfo{
+			Name:    info.Name,
+			Tags:    info.Tags,
+			Summary: info.Summary,
+			Default: isDefault[info.Name],
+		}
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (s *server) handleCheck(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		httpError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+		return
+	}
+
+	var req checkRequest
+	body := http.MaxBytesReader(w, r.Body, s.limits.maxBodySize)
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		httpError(w, http.StatusBadRequest, "decode request: "+err.Error())
+		return
+	}
+	files, err := s.requestFiles(&req)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	goVersion, err := linter.ParseGoVersion(req.GoVersion)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	infoList, err := s.selectCheckers(req.Enable, req.Disable)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	timeout := time.NewTimer(s.limits.timeout)
+	defer timeout.Stop()
+	select {
+	case s.sem <- struct{}{}:
+	case <-timeout.C:
+		httpError(w, http.StatusServiceUnavailable, "server is busy, try again later")
+		return
+	case <-r.Context().Done():
+		return
+	}
+
+	// Checkers can't be interrupted, so the check goroutine
+	// holds the semaphore until it's finished, even if
+	// the request is timed out.
+	done := make(chan *checkResponse, 1)
+	errc := make(chan error, 1)
+	go func() {
+		defer func() { <-s.sem }()
+		resp, err := check(files, infoList, goVersion)
+		if err != nil {
+			errc <- err
+			return
+		}
+		done <- resp
+	}()
+
+	select {
+	case resp := <-done:
+		writeJSON(w, http.StatusOK, resp)
+	case err := <-errc:
+		log.Printf("serve: %v", err)
+		httpError(w, http.StatusInternalServerError, err.Error())
+	case <-timeout.C:
+		httpError(w, http.StatusServiceUnavailable, "check timed out")
+	case <-r.Context().Done():
+	}
+}
+
+// requestFiles validates req files and returns them.
+func (s *server) requestFiles(req *checkRequest) (map[string]string, error) {
+	files := req.Files
+	if req.Source != "" {
+		if len(files) != 0 {
+			return nil, errors.New("source and files can't be used together")
+		}
+		files = map[string]string{"main.go": req.Source}
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no files to check")
+	}
+	if len(files) > s.limits.maxFiles {
+		return nil, fmt.Errorf("too many files: %d, max is %d", len(files), s.limits.maxFiles)
+	}
+	for name := range files {
+		if !strings.HasSuffix(name, ".go") || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+			return nil, fmt.Errorf("invalid file name %q", name)
+		}
+	}
+	return files, nil
+}
+
+// selectCheckers returns available checkers that are enabled by the enable
+// and not disabled by the disable lists.
+func (s *server) selectCheckers(enable, disable []string) ([]*linter.CheckerInfo, error) {
+	if len(enable) == 0 {
+		enable = s.defaults
+	}
+	return gocritic.SelectCheckers(gocritic.Config{
+		Enable:  enable,
+		Disable: append(disable[:len(disable):len(disable)], s.disable...),
+	})
+}
+
+// check type checks the files and runs checkers over them.
+func check(files map[string]string, infoList []*linter.CheckerInfo, goVersion linter.GoVersion) (*checkResponse, error) {
+	sizes := types.SizesFor("gc", build.Default.GOARCH)
+	if sizes == nil {
+		return nil, fmt.Errorf("can't find sizes info for %s", build.Default.GOARCH)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &checkResponse{Warnings: []warning{}}
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, name, files[name], parser.ParseComments)
+		if err != nil {
+			resp.Errors = append(resp.Errors, err.Error())
+			continue
+		}
+		parsed = append(parsed, f)
+	}
+	if len(resp.Errors) != 0 {
+		return resp, nil
+	}
+
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+	}
+	typesConfig := types.Config{
+		Importer: stdImporter{importer.ForCompiler(fset, "source", nil)},
+		Sizes:    sizes,
+		Error: func(err error) {
+			resp.Errors = append(resp.Errors, err.Error())
+		},
+	}
+	pkg, _ := typesConfig.Check(parsed[0].Name.Name, fset, parsed, info)
+	if len(resp.Errors) != 0 {
+		return resp, nil
+	}
+
+	ctx := linter.NewContext(fset, sizes)
+	ctx.SetPackageInfo(info, pkg)
+	ctx.GoVersion = goVersion
+	for _, f := range parsed {
+		name := fset.Position(f.Pos()).Filename
+		ctx.SetFileInfo(name, f)
+		ctx.SetFileSource([]byte(files[name]))
+		for _, info := range infoList {
+			c := linter.NewChecker(ctx, info)
+			warnings, err := gocritic.CheckFile(c, f)
+			if err != nil {
+				return nil, err
+			}
+			for _, warn := range warnings {
+				resp.Warnings = append(resp.Warnings, newWarning(fset, info.Name, warn))
+			}
+		}
+	}
+	sort.SliceStable(resp.Warnings, func(i, j int) bool {
+		x, y := resp.Warnings[i].Pos, resp.Warnings[j].Pos
+		if x.File != y.File {
+			return x.File < y.File
+		}
+		return x.Offset < y.Offset
+	})
+	return resp, nil
+}
+
+func newWarning(fset *token.FileSet, checker string, warn linter.Warning) warning {
+	w := warning{
+		Checker: checker,
+		Message: warn.Text,
+		Pos:     newPosition(fset, warn.Node.Pos()),
+		End:     newPosition(fset, warn.Node.End()),
+	}
+	if warn.HasQuickFix() {
+		w.Fix = &quickFix{
+			Pos:         newPosition(fset, warn.Suggestion.From),
+			End:         newPosition(fset, warn.Suggestion.To),
+			Replacement: string(warn.Suggestion.Replacement),
+		}
+	}
+	return w
+}
+
+func newPosition(fset *token.FileSet, pos token.Pos) position {
+	p := fset.Position(pos)
+	return position{
+		File:   p.Filename,
+		Offset: p.Offset,
+		Line:   p.Line,
+		Column: p.Column,
+	}
+}
+
+// stdImporter only imports the standard library packages,
+// so the clients can't make the server read arbitrary files.
+type stdImporter struct {
+	imp types.Importer
+}
+
+func (imp stdImporter) Import(path string) (*types.Package, error) {
+	if !isStdlibPath(path) {
+		return nil, fmt.Errorf("can't import %q: only the standard library packages are available", path)
+	}
+	return imp.imp.Import(path)
+}
+
+func isStdlibPath(path string) bool {
+	if path == "C" || strings.HasPrefix(path, ".") || strings.HasPrefix(path, "/") {
+		return false
+	}
+	first := path
+	if i := strings.IndexByte(path, '/'); i != -1 {
+		first = path[:i]
+	}
+	return !strings.Contains(first, ".") && first != "internal" && first != "vendor"
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("serve: write response: %v", err)
+	}
+}
+
+func httpError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
@@ -0,0 +1,157 @@
+package serve
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/go-critic/go-critic/checkers" // Register go-critic checkers
+)
+
+func newTestServer(t *testing.T) *server {
+	s, err := newServer(nil, []string{"ruleguard"}, limits{
+		maxBodySize:   1 << 16,
+		maxFiles:      2,
+		maxConcurrent: 1,
+		timeout:       time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func postCheck(t *testing.T, s *server, req interface{}) *httptest.ResponseRecorder {
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/check", bytes.NewReader(data)))
+	return rec
+}
+
+func TestCheck(t *testing.T) {
+	s := newTestServer(t)
+	rec := postCheck(t, s, checkRequest{
+		Files: map[string]string{
+			"a.go": "package p\n\nfunc f(a int, b int) {}\n",
+			"b.go": "package p\n\nimport \"strings\"\n\nfunc g(xs []string) bool {\n\treturn len(xs) >= 0 && strings.HasPrefix(xs[0], \"x\")\n}\n",
+		},
+		Enable: []string{"paramTypeCombine", "sloppyLen"},
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	var resp checkResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", resp.Errors)
+	}
+
+	want := []struct {
+		checker string
+		file    string
+		line    int
+		hasFix  bool
+	}{
+		{"paramTypeCombine", "a.go", 3, true},
+		{"sloppyLen", "b.go", 6, false},
+	}
+	if len(resp.Warnings) != len(want) {
+		t.Fatalf("expected %d warnings, got %+v", len(want), resp.Warnings)
+	}
+	for i, w := range want {
+		have := resp.Warnings[i]
+		if have.Checker != w.checker || have.Pos.File != w.file || have.Pos.Line != w.line {
+			t.Errorf("warning %d: have %s %s:%d, want %s %s:%d",
+				i, have.Checker, have.Pos.File, have.Pos.Line, w.checker, w.file, w.line)
+		}
+		if (have.Fix != nil) != w.hasFix {
+			t.Errorf("warning %d: have fix %+v, want fix=%v", i, have.Fix, w.hasFix)
+		}
+	}
+	if fix := resp.Warnings[0].Fix; fix != nil && fix.Replacement != "(a, b int)" {
+		t.Errorf("unexpected fix replacement %q", fix.Replacement)
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	s := newTestServer(t)
+	rec := postCheck(t, s, checkRequest{
+		Source: "package main\n\nimport \"example.com/x\"\n\nfunc main() { x.F() }\n",
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	var resp checkResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0], "only the standard library") {
+		t.Errorf("unexpected errors: %v", resp.Errors)
+	}
+	if strings.Contains(rec.Body.String(), "gocritic-serve") {
+		t.Errorf("response leaks temporary dir: %s", rec.Body)
+	}
+}
+
+func TestCheckBadRequests(t *testing.T) {
+	s := newTestServer(t)
+	tests := []struct {
+		req  interface{}
+		want string
+	}{
+		{checkRequest{}, "no files to check"},
+		{checkRequest{Source: "package p", Files: map[string]string{"a.go": "package p"}}, "can't be used together"},
+		{checkRequest{Files: map[string]string{"../a.go": "package p"}}, `invalid file name "../a.go"`},
+		{checkRequest{Files: map[string]string{"a.txt": "package p"}}, `invalid file name "a.txt"`},
+		{checkRequest{Files: map[string]string{"a.go": "", "b.go": "", "c.go": ""}}, "too many files"},
+		{checkRequest{Source: "package p", Enable: []string{"ruleguard"}}, "empty checkers set selected"},
+		{checkRequest{Source: "package p", GoVersion: "x"}, "invalid Go version"},
+		{checkRequest{Source: strings.Repeat("x", 1<<16)}, "decode request"},
+	}
+	for _, test := range tests {
+		rec := postCheck(t, s, test.req)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%+v: status %d, want %d", test.want, rec.Code, http.StatusBadRequest)
+			continue
+		}
+		var resp struct{ Error string }
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(resp.Error, test.want) {
+			t.Errorf("error %q doesn't contain %q", resp.Error, test.want)
+		}
+	}
+}
+
+func TestCheckers(t *testing.T) {
+	s := newTestServer(t)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/checkers", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	var list []checkerInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]checkerInfo)
+	for _, info := range list {
+		byName[info.Name] = info
+	}
+	if _, ok := byName["ruleguard"]; ok {
+		t.Errorf("disabled checker is listed")
+	}
+	if !byName["assignOp"].Default || byName["hugeParam"].Default {
+		t.Errorf("unexpected default checkers: assignOp=%+v hugeParam=%+v", byName["assignOp"], byName["hugeParam"])
+	}
+}
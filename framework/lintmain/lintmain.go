@@ -7,6 +7,8 @@ import (
 	"github.com/go-critic/go-critic/framework/cmdutil"
 	"github.com/go-critic/go-critic/framework/lintmain/internal/check"
 	"github.com/go-critic/go-critic/framework/lintmain/internal/lintdoc"
+	"github.com/go-critic/go-critic/framework/lintmain/internal/lsp"
+	"github.com/go-critic/go-critic/framework/lintmain/internal/serve"
 )
 
 // Config is used to parametrize the linter.
@@ -56,6 +58,22 @@ func Run(cfg Config) {
 				"%s doc",
 				"%s doc checkerName"),
 		},
+		{
+			Main:  lsp.Main,
+			Name:  "lsp",
+			Short: "run language server that reports warnings for opened files",
+			Examples: makeExamples(
+				"%s lsp",
+				"%s lsp -enable='#diagnostic' -delay=1s"),
+		},
+		{
+			Main:  serve.Main,
+			Name:  "serve",
+			Short: "run HTTP server that checks posted Go sources",
+			Examples: makeExamples(
+				"%s serve -help",
+				"%s serve -addr=:8080 -disable='#experimental' -timeout=5s"),
+		},
 	}
 
 	cmdutil.DispatchCommand(subCommands)
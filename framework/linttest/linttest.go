@@ -4,15 +4,18 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/go-critic/go-critic/framework/linter"
 	"github.com/go-toolsmith/pkgload"
+	"github.com/google/go-cmp/cmp"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -25,7 +28,8 @@ func saneCheckersList(t *testing.T) []*linter.CheckerInfo {
 		pkgPath := "github.com/go-critic/go-critic/framework/linttest/testdata/sanity"
 		t.Run(info.Name+"/sanity", func(t *testing.T) {
 			fset := token.NewFileSet()
-			pkgs := newPackages(t, pkgPath, fset)
+			var sources linter.FileSources
+			pkgs := newPackages(t, pkgPath, fset, &sources)
 			for _, pkg := range pkgs {
 				ctx := &linter.Context{
 					SizesInfo: sizes,
@@ -44,6 +48,7 @@ func saneCheckersList(t *testing.T) []*linter.CheckerInfo {
 				}()
 				for _, f := range pkg.Syntax {
 					ctx.SetFileInfo(getFilename(fset, f), f)
+					ctx.SetFileSource(sources.Get(fset.Position(f.Pos()).Filename))
 					_ = c.Check(f)
 				}
 			}
@@ -53,6 +58,11 @@ func saneCheckersList(t *testing.T) []*linter.CheckerInfo {
 	return saneList
 }
 
+// GoVersions maps checker names to Go versions that are used
+// to run their tests. Checkers that are not listed are tested
+// with zero value version which is treated as the latest one.
+var GoVersions = map[string]linter.GoVersion{}
+
 // IntegrationTest specifies integration test options.
 type IntegrationTest struct {
 	Main string
@@ -71,24 +81,26 @@ func TestCheckers(t *testing.T) {
 			pkgPath := "./testdata/" + info.Name
 
 			fset := token.NewFileSet()
-			pkgs := newPackages(t, pkgPath, fset)
+			var sources linter.FileSources
+			pkgs := newPackages(t, pkgPath, fset, &sources)
 			for _, pkg := range pkgs {
 				ctx := &linter.Context{
 					SizesInfo: sizes,
 					FileSet:   fset,
 					TypesInfo: pkg.TypesInfo,
 					Pkg:       pkg.Types,
+					GoVersion: GoVersions[info.Name],
 				}
 				c := linter.NewChecker(ctx, info)
 				for _, f := range pkg.Syntax {
-					checkFile(t, c, ctx, f)
+					checkFile(t, c, ctx, f, sources.Get(fset.Position(f.Pos()).Filename))
 				}
 			}
 		})
 	}
 }
 
-func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File) {
+func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File, src []byte) {
 	filename := getFilename(ctx.FileSet, f)
 	testFilename := filepath.Join("testdata", c.Info.Name, filename)
 
@@ -105,9 +117,11 @@ func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File
 
 	stripDirectives(f)
 	ctx.SetFileInfo(filename, f)
+	ctx.SetFileSource(src)
 
 	matched := make(map[*string]struct{})
-	for _, warn := range c.Check(f) {
+	warnings := c.Check(f)
+	for _, warn := range warnings {
 		line := ctx.FileSet.Position(warn.Node.Pos()).Line
 
 		if w := ws.find(line, warn.Text); w != nil {
@@ -123,6 +137,53 @@ func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File
 	}
 
 	checkUnmatched(ws, matched, t, testFilename)
+	checkQuickFixes(t, ctx.FileSet, testFilename, warnings)
+}
+
+// checkQuickFixes applies all suggested fixes to the test file and compares
+// the result with the "<testFilename>.golden" file contents.
+// Files without golden counterpart are not checked.
+func checkQuickFixes(t *testing.T, fset *token.FileSet, testFilename string, warnings []linter.Warning) {
+	want, err := ioutil.ReadFile(testFilename + ".golden")
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+	src, err := ioutil.ReadFile(testFilename)
+	if err != nil {
+		t.Fatalf("read file %q: %v", testFilename, err)
+	}
+
+	var fixes []linter.QuickFix
+	for _, warn := range warnings {
+		if warn.HasQuickFix() {
+			fixes = append(fixes, warn.Suggestion)
+		}
+	}
+	// Apply fixes from the end of the file, so the offsets
+	// of the remaining fixes are not invalidated.
+	sort.Slice(fixes, func(i, j int) bool {
+		return fixes[i].From > fixes[j].From
+	})
+	prevFrom := len(src)
+	for _, fix := range fixes {
+		from := fset.Position(fix.From).Offset
+		to := fset.Position(fix.To).Offset
+		if to > prevFrom {
+			t.Errorf("%s: overlapping quick fix at offset %d", testFilename, from)
+			continue
+		}
+		src = append(src[:from:from], append(fix.Replacement, src[to:]...)...)
+		prevFrom = from
+	}
+
+	wantLines := strings.Split(string(want), "\n")
+	haveLines := strings.Split(string(src), "\n")
+	if diff := cmp.Diff(wantLines, haveLines); diff != "" {
+		t.Errorf("%s: quick fixes output mismatch:\n%s", testFilename, diff)
+	}
 }
 
 // stripDirectives replaces "///" comments with empty single-line
@@ -153,7 +214,7 @@ func checkUnmatched(ws warnings, matched map[*string]struct{}, t *testing.T, tes
 	}
 }
 
-func newPackages(t *testing.T, pattern string, fset *token.FileSet) []*packages.Package {
+func newPackages(t *testing.T, pattern string, fset *token.FileSet, sources *linter.FileSources) []*packages.Package {
 	mode := packages.NeedName |
 		packages.NeedFiles |
 		packages.NeedCompiledGoFiles |
@@ -163,9 +224,10 @@ func newPackages(t *testing.T, pattern string, fset *token.FileSet) []*packages.
 		packages.NeedTypesInfo |
 		packages.NeedTypesSizes
 	cfg := packages.Config{
-		Mode:  mode,
-		Tests: true,
-		Fset:  fset,
+		Mode:      mode,
+		Tests:     true,
+		Fset:      fset,
+		ParseFile: sources.ParseFile,
 	}
 	pkgs, err := loadPackages(&cfg, []string{pattern})
 	if err != nil {
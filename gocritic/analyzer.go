@@ -0,0 +1,177 @@
+package gocritic
+
+import (
+	"flag"
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer runs go-critic checkers as a go/analysis pass,
+// so they can be used with gopls, go vet -vettool and other analysis drivers.
+//
+// Checkers set and their params are configured with the analyzer flags
+// that match the gocritic check command flags: -enable, -disable,
+// -enableAll, -go and -@checkerName.paramName.
+//
+// Quick fixes are reported as diagnostics suggested fixes.
+//
+// The analyzer is self-contained, so it can be used by the drivers
+// that don't run go/packages, like Bazel nogo:
+//   - every package is checked using only the *analysis.Pass data;
+//   - it neither produces nor requires facts;
+//   - flags are only read during the analysis, checkers are
+//     created per pass, so concurrent passes don't share any state;
+//   - errors are returned from Run instead of exiting the process.
+var Analyzer = &analysis.Analyzer{
+	Name: "gocritic",
+	Doc:  "runs go-critic checkers that detect style, performance and correctness issues",
+	Run:  runAnalyzer,
+}
+
+var analyzerFlags struct {
+	enable    string
+	disable   string
+	enableAll bool
+	goVersion string
+}
+
+func init() {
+	flags := &Analyzer.Flags
+	flags.StringVar(&analyzerFlags.enable, "enable", strings.Join(DefaultCheckers(), ","),
+		`comma-separated list of enabled checkers. Can include #tags`)
+	flags.StringVar(&analyzerFlags.disable, "disable", "",
+		`comma-separated list of checkers to be disabled. Can include #tags`)
+	flags.BoolVar(&analyzerFlags.enableAll, "enableAll", false,
+		`identical to -enable with all checkers listed. If true, -enable is ignored`)
+	flags.StringVar(&analyzerFlags.goVersion, "go", "",
+		`target Go version, like 1.20; if empty, the package Go version reported by the driver is used`)
+
+	for _, info := range linter.GetCheckersInfo() {
+		for pname, param := range info.Params {
+			flags.Var(paramFlag{param}, "@"+info.Name+"."+pname, param.Usage)
+		}
+	}
+}
+
+func runAnalyzer(pass *analysis.Pass) (interface{}, error) {
+	goVersion, err := linter.ParseGoVersion(analyzerFlags.goVersion)
+	if err != nil {
+		return nil, err
+	}
+	goVersion = passGoVersion(pass, goVersion)
+
+	sizes := pass.TypesSizes
+	if sizes == nil {
+		// Not every driver provides the sizes info.
+		sizes = types.SizesFor("gc", runtime.GOARCH)
+	}
+	ctx := linter.NewContext(pass.Fset, sizes)
+	ctx.SetPackageInfo(pass.TypesInfo, pass.Pkg)
+	ctx.GoVersion = goVersion
+	checkers, err := newCheckers(ctx, Config{
+		Enable:    []string{analyzerFlags.enable},
+		Disable:   []string{analyzerFlags.disable},
+		EnableAll: analyzerFlags.enableAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range pass.Files {
+		if isGenerated(f) {
+			continue
+		}
+		ctx.SetFileInfo(filepath.Base(pass.Fset.Position(f.Pos()).Filename), f)
+		ctx.SetFileSource(readSource(pass.Fset, f))
+		for _, c := range checkers {
+			warnings, err := CheckFile(c, f)
+			if err != nil {
+				return nil, err
+			}
+			for _, warn := range warnings {
+				pass.Report(newDiagnostic(c.Info.Name, warn))
+			}
+		}
+	}
+	return nil, nil
+}
+
+// passGoVersion returns v if it's set, the pass package Go version otherwise.
+// Drivers set the package version from the module go directive;
+// if it's unknown, the latest version is assumed.
+func passGoVersion(pass *analysis.Pass, v linter.GoVersion) linter.GoVersion {
+	if !v.IsAny() || pass.Pkg == nil {
+		return v
+	}
+	pkgVersion, err := linter.ParseGoVersion(pass.Pkg.GoVersion())
+	if err != nil {
+		return v
+	}
+	return pkgVersion
+}
+
+func newDiagnostic(checker string, warn linter.Warning) analysis.Diagnostic {
+	diag := analysis.Diagnostic{
+		Pos:      warn.Node.Pos(),
+		End:      warn.Node.End(),
+		Category: checker,
+		Message:  checker + ": " + warn.Text,
+	}
+	if warn.HasQuickFix() {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: checker + ": apply suggested fix",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     warn.Suggestion.From,
+				End:     warn.Suggestion.To,
+				NewText: warn.Suggestion.Replacement,
+			}},
+		}}
+	}
+	return diag
+}
+
+// paramFlag is a flag.Value that assigns checker param value directly.
+type paramFlag struct {
+	param *linter.CheckerParam
+}
+
+var _ flag.Value = paramFlag{}
+
+func (f paramFlag) String() string {
+	if f.param == nil {
+		return ""
+	}
+	return fmt.Sprint(f.param.Value)
+}
+
+func (f paramFlag) IsBoolFlag() bool {
+	_, ok := f.param.Value.(bool)
+	return ok
+}
+
+func (f paramFlag) Set(s string) error {
+	switch f.param.Value.(type) {
+	case int:
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		f.param.Value = v
+	case bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.param.Value = v
+	case string:
+		f.param.Value = s
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package gocritic
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sync"
+	"testing"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	if err := Analyzer.Flags.Set("enable", "paramTypeCombine, sloppyLen"); err != nil {
+		t.Fatal(err)
+	}
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), Analyzer, "a")
+}
+
+// TestAnalyzerWithoutLoader runs the analyzer the way Bazel nogo does:
+// without go/packages and with several packages analyzed concurrently.
+func TestAnalyzerWithoutLoader(t *testing.T) {
+	if err := Analyzer.Flags.Set("enable", "paramTypeCombine, sloppyLen"); err != nil {
+		t.Fatal(err)
+	}
+
+	const src = `package p
+
+func f(a int, b int, xs []int) {
+	_ = len(xs) >= 0
+}
+`
+	want := []string{
+		"paramTypeCombine: func(a int, b int, xs []int) could be replaced with func(a, b int, xs []int)",
+		"sloppyLen: len(xs) >= 0 is always true",
+	}
+
+	const numPasses = 4
+	results := make([][]string, numPasses)
+	errs := make([]error, numPasses)
+	var wg sync.WaitGroup
+	for i := 0; i < numPasses; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = runPass(src)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < numPasses; i++ {
+		if errs[i] != nil {
+			t.Fatalf("pass %d: %v", i, errs[i])
+		}
+		if diff := cmp.Diff(want, results[i]); diff != "" {
+			t.Errorf("pass %d: diagnostics mismatch (-want +have):\n%s", i, diff)
+		}
+	}
+}
+
+// runPass type checks src and runs Analyzer over it.
+// It intentionally leaves out the optional Pass fields, like TypesSizes.
+func runPass(src string) ([]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+	}
+	files := []*ast.File{f}
+	pkg, err := new(types.Config).Check("p", fset, files, info)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	pass := &analysis.Pass{
+		Analyzer:  Analyzer,
+		Fset:      fset,
+		Files:     files,
+		Pkg:       pkg,
+		TypesInfo: info,
+		Report: func(d analysis.Diagnostic) {
+			messages = append(messages, d.Message)
+		},
+	}
+	if _, err := Analyzer.Run(pass); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func TestPassGoVersion(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", "package p\n", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{GoVersion: "go1.18"}
+	pkg, err := conf.Check("p", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pass := &analysis.Pass{Pkg: pkg}
+
+	if have := passGoVersion(pass, linter.GoVersion{}); have != (linter.GoVersion{Major: 1, Minor: 18}) {
+		t.Errorf("package version: have %v, want 1.18", have)
+	}
+	flagVersion := linter.GoVersion{Major: 1, Minor: 21}
+	if have := passGoVersion(pass, flagVersion); have != flagVersion {
+		t.Errorf("flag version: have %v, want 1.21", have)
+	}
+}
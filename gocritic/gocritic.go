@@ -0,0 +1,399 @@
+// Package gocritic provides an API to run go-critic checkers
+// from other programs without going through the command-line tool.
+package gocritic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	_ "github.com/go-critic/go-critic/checkers" // Register go-critic checkers
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/pkgload"
+	"golang.org/x/tools/go/packages"
+)
+
+// Config describes what to check and how.
+//
+// Zero value checks nothing; Patterns must be set.
+type Config struct {
+	// Patterns is a list of packages to check, like "./...".
+	Patterns []string
+
+	// Dir is a directory in which packages are loaded.
+	// If empty, the current working directory is used.
+	Dir string
+
+	// Enable is a list of enabled checkers names and #tags.
+	// If empty, the same checkers set as in the command-line tool is used.
+	Enable []string
+
+	// Disable is a list of disabled checkers names and #tags.
+	// Disable has a priority over Enable.
+	Disable []string
+
+	// EnableAll enables every registered checker; Enable is ignored.
+	EnableAll bool
+
+	// Params overrides checker params defaults.
+	// It maps checker names to a param name => value mapping.
+	// Value types must match the default values types.
+	Params map[string]map[string]interface{}
+
+	// SkipTests disables the _test.go files checking.
+	SkipTests bool
+
+	// CheckGenerated enables checking of generated files.
+	CheckGenerated bool
+
+	// GoVersion is a target Go version, like "1.20".
+	// If empty, the module go directive is used.
+	GoVersion string
+}
+
+// Issue is a single problem found by a checker.
+type Issue struct {
+	// Checker is a name of the checker that reported the issue.
+	Checker string
+
+	// Message is a warning text without location info.
+	Message string
+
+	// Pos is an issue location.
+	Pos token.Position
+
+	// Fix is a suggested fix, if any.
+	Fix *Fix
+}
+
+// Fix is a suggested replacement of the [Pos, End) source range.
+type Fix struct {
+	Pos         token.Position
+	End         token.Position
+	Replacement []byte
+}
+
+// runMu serializes Run calls, since checker params are stored
+// in the global checkers registry.
+var runMu sync.Mutex
+
+// Run loads packages described by cfg and returns issues found in them.
+// Issues are sorted by their position.
+//
+// Run modifies registered checkers params, so concurrent calls are serialized.
+func Run(ctx context.Context, cfg Config) ([]Issue, error) {
+	runMu.Lock()
+	defer runMu.Unlock()
+
+	if len(cfg.Patterns) == 0 {
+		return nil, errors.New("no packages to check")
+	}
+	goVersion, err := linter.ParseGoVersion(cfg.GoVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	infoList := linter.GetCheckersInfo()
+	restore, err := setParams(infoList, cfg.Params)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	sizes := types.SizesFor("gc", build.Default.GOARCH)
+	if sizes == nil {
+		return nil, fmt.Errorf("can't find sizes info for %s", build.Default.GOARCH)
+	}
+	fset := token.NewFileSet()
+	var sources linter.FileSources
+	pkgs, err := loadPackages(ctx, fset, &sources, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	lintCtx := linter.NewContext(fset, sizes)
+	checkers, err := newCheckers(lintCtx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	for _, pkg := range pkgs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		lintCtx.SetPackageInfo(pkg.TypesInfo, pkg.Types)
+		lintCtx.GoVersion = PackageGoVersion(pkg, goVersion)
+		for _, f := range pkg.Syntax {
+			filename := filepath.Base(fset.Position(f.Pos()).Filename)
+			if cfg.SkipTests && strings.HasSuffix(filename, "_test.go") {
+				continue
+			}
+			if !cfg.CheckGenerated && isGenerated(f) {
+				continue
+			}
+			lintCtx.SetFileInfo(filename, f)
+			lintCtx.SetFileSource(sources.Get(fset.Position(f.Pos()).Filename))
+			for _, c := range checkers {
+				warnings, err := CheckFile(c, f)
+				if err != nil {
+					return nil, err
+				}
+				for _, warn := range warnings {
+					issues = append(issues, newIssue(fset, c.Info.Name, warn))
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		x, y := issues[i].Pos, issues[j].Pos
+		if x.Filename != y.Filename {
+			return x.Filename < y.Filename
+		}
+		return x.Offset < y.Offset
+	})
+	return issues, nil
+}
+
+func newIssue(fset *token.FileSet, checker string, warn linter.Warning) Issue {
+	issue := Issue{
+		Checker: checker,
+		Message: warn.Text,
+		Pos:     fset.Position(warn.Node.Pos()),
+	}
+	if warn.HasQuickFix() {
+		issue.Fix = &Fix{
+			Pos:         fset.Position(warn.Suggestion.From),
+			End:         fset.Position(warn.Suggestion.To),
+			Replacement: warn.Suggestion.Replacement,
+		}
+	}
+	return issue
+}
+
+// CheckFile runs c over f, checker panics are reported as errors.
+//
+// Unlike c.Check, it returns a new slice for every call,
+// so the results of the previous calls stay valid.
+func CheckFile(c *linter.Checker, f *ast.File) (warnings []linter.Warning, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic: %v", c.Info.Name, r)
+		}
+	}()
+	// Check reuses its result slice, so it's copied.
+	return append([]linter.Warning(nil), c.Check(f)...), nil
+}
+
+// setParams assigns params values to the matching checker params.
+// Returned func restores the previous values.
+func setParams(infoList []*linter.CheckerInfo, params map[string]map[string]interface{}) (func(), error) {
+	byName := make(map[string]*linter.CheckerInfo, len(infoList))
+	for _, info := range infoList {
+		byName[info.Name] = info
+	}
+
+	var undo []func()
+	restore := func() {
+		for _, f := range undo {
+			f()
+		}
+	}
+	for name, values := range params {
+		info, ok := byName[name]
+		if !ok {
+			restore()
+			return nil, fmt.Errorf("unknown checker %q", name)
+		}
+		for pname, v := range values {
+			p, ok := info.Params[pname]
+			if !ok {
+				restore()
+				return nil, fmt.Errorf("%s: unknown param %q", name, pname)
+			}
+			if fmt.Sprintf("%T", v) != fmt.Sprintf("%T", p.Value) {
+				restore()
+				return nil, fmt.Errorf("%s: param %q expects %T value, got %T", name, pname, p.Value, v)
+			}
+			old := p.Value
+			undo = append(undo, func() { p.Value = old })
+			p.Value = v
+		}
+	}
+	return restore, nil
+}
+
+func newCheckers(ctx *linter.Context, cfg Config) ([]*linter.Checker, error) {
+	infoList, err := SelectCheckers(cfg)
+	if err != nil {
+		return nil, err
+	}
+	checkers := make([]*linter.Checker, len(infoList))
+	for i, info := range infoList {
+		checkers[i] = linter.NewChecker(ctx, info)
+	}
+	return checkers, nil
+}
+
+// SelectCheckers returns registered checkers that are selected
+// by the cfg Enable, Disable and EnableAll fields.
+//
+// Enable and Disable elements may also be comma-separated lists,
+// so the command-line flags values can be passed as is.
+// Returns an error if no checkers are selected.
+func SelectCheckers(cfg Config) ([]*linter.CheckerInfo, error) {
+	enabled := newMatcher(cfg.Enable)
+	if enabled.empty() {
+		enabled = newMatcher(DefaultCheckers())
+	}
+	disabled := newMatcher(cfg.Disable)
+
+	var infoList []*linter.CheckerInfo
+	for _, info := range linter.GetCheckersInfo() {
+		if (cfg.EnableAll || enabled.match(info)) && !disabled.match(info) {
+			infoList = append(infoList, info)
+		}
+	}
+	if len(infoList) == 0 {
+		return nil, errors.New("empty checkers set selected")
+	}
+	return infoList, nil
+}
+
+// DefaultCheckers returns the names of checkers that are enabled
+// when Config.Enable is empty.
+func DefaultCheckers() []string {
+	var names []string
+	for _, info := range linter.GetCheckersInfo() {
+		enable := !info.HasTag("experimental") &&
+			!info.HasTag("opinionated") &&
+			!info.HasTag("performance")
+		if enable {
+			names = append(names, info.Name)
+		}
+	}
+	return names
+}
+
+// matcher matches checkers by their names and #tags.
+type matcher struct {
+	names map[string]bool
+	tags  map[string]bool
+}
+
+func newMatcher(keys []string) matcher {
+	m := matcher{
+		names: make(map[string]bool),
+		tags:  make(map[string]bool),
+	}
+	for _, list := range keys {
+		for _, key := range strings.Split(list, ",") {
+			key = strings.TrimSpace(key)
+			switch {
+			case key == "":
+				continue
+			case strings.HasPrefix(key, "#"):
+				m.tags[key[len("#"):]] = true
+			default:
+				m.names[key] = true
+			}
+		}
+	}
+	return m
+}
+
+func (m matcher) empty() bool {
+	return len(m.names) == 0 && len(m.tags) == 0
+}
+
+func (m matcher) match(info *linter.CheckerInfo) bool {
+	if m.names[info.Name] {
+		return true
+	}
+	for _, tag := range info.Tags {
+		if m.tags[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+func loadPackages(ctx context.Context, fset *token.FileSet, sources *linter.FileSources, cfg Config) ([]*packages.Package, error) {
+	mode := packages.NeedName |
+		packages.NeedFiles |
+		packages.NeedCompiledGoFiles |
+		packages.NeedImports |
+		packages.NeedTypes |
+		packages.NeedSyntax |
+		packages.NeedTypesInfo |
+		packages.NeedTypesSizes |
+		packages.NeedModule
+	loadCfg := packages.Config{
+		Context:   ctx,
+		Dir:       cfg.Dir,
+		Mode:      mode,
+		Tests:     !cfg.SkipTests,
+		Fset:      fset,
+		ParseFile: sources.ParseFile,
+	}
+	pkgs, err := packages.Load(&loadCfg, cfg.Patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("load packages: %v", err)
+	}
+	var errs []string
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err.Error())
+		}
+	})
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("load packages: %s", strings.Join(errs, "; "))
+	}
+
+	result := pkgs[:0]
+	pkgload.VisitUnits(pkgs, func(u *pkgload.Unit) {
+		if u.ExternalTest != nil {
+			result = append(result, u.ExternalTest)
+		}
+		if u.Test != nil {
+			// Prefer tests to the base package, if present.
+			result = append(result, u.Test)
+		} else {
+			result = append(result, u.Base)
+		}
+	})
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].PkgPath < result[j].PkgPath
+	})
+	return result, nil
+}
+
+// PackageGoVersion returns v if it's set, the pkg module go directive otherwise.
+// The pkg must be loaded with packages.NeedModule mode.
+func PackageGoVersion(pkg *packages.Package, v linter.GoVersion) linter.GoVersion {
+	if !v.IsAny() || pkg.Module == nil {
+		return v
+	}
+	modVersion, err := linter.ParseGoVersion(pkg.Module.GoVersion)
+	if err != nil {
+		return v
+	}
+	return modVersion
+}
+
+var generatedFileCommentRE = regexp.MustCompile("Code generated .* DO NOT EDIT.")
+
+func isGenerated(f *ast.File) bool {
+	return len(f.Comments) != 0 &&
+		generatedFileCommentRE.MatchString(f.Comments[0].Text())
+}
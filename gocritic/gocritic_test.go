@@ -0,0 +1,96 @@
+package gocritic
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	issues, err := Run(context.Background(), Config{
+		Patterns: []string{"./testdata/sample"},
+		Enable:   []string{"assignOp", "sloppyLen"},
+	})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	want := []struct {
+		checker string
+		line    int
+		message string
+	}{
+		{"assignOp", 4, "replace `x = x + 1` with `x++`"},
+		{"sloppyLen", 9, "len(xs) >= 0 is always true"},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("expected %d issues, got %d: %+v", len(want), len(issues), issues)
+	}
+	for i, w := range want {
+		issue := issues[i]
+		if issue.Checker != w.checker || issue.Pos.Line != w.line || issue.Message != w.message {
+			t.Errorf("issue %d:\nhave: %s:%d: %s\nwant: %s:%d: %s",
+				i, issue.Checker, issue.Pos.Line, issue.Message, w.checker, w.line, w.message)
+		}
+		if filepath.Base(issue.Pos.Filename) != "sample.go" {
+			t.Errorf("issue %d: unexpected filename %q", i, issue.Pos.Filename)
+		}
+	}
+}
+
+func TestRunParams(t *testing.T) {
+	tests := []struct {
+		params map[string]map[string]interface{}
+		err    string
+	}{
+		{
+			params: map[string]map[string]interface{}{"noSuchChecker": {"x": 1}},
+			err:    `unknown checker "noSuchChecker"`,
+		},
+		{
+			params: map[string]map[string]interface{}{"hugeParam": {"noSuchParam": 1}},
+			err:    `hugeParam: unknown param "noSuchParam"`,
+		},
+		{
+			params: map[string]map[string]interface{}{"hugeParam": {"sizeThreshold": "80"}},
+			err:    `hugeParam: param "sizeThreshold" expects int value, got string`,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := Run(context.Background(), Config{
+			Patterns: []string{"./testdata/sample"},
+			Params:   test.params,
+		})
+		if err == nil || err.Error() != test.err {
+			t.Errorf("params %v:\nhave error: %v\nwant error: %s", test.params, err, test.err)
+		}
+	}
+}
+
+func TestRunNoPatterns(t *testing.T) {
+	if _, err := Run(context.Background(), Config{}); err == nil {
+		t.Error("expected an error for empty patterns list")
+	}
+}
+
+func TestSelectCheckers(t *testing.T) {
+	infoList, err := SelectCheckers(Config{
+		Enable:  []string{"assignOp, sloppyLen", "hugeParam"},
+		Disable: []string{"hugeParam,"},
+	})
+	if err != nil {
+		t.Fatalf("select: %v", err)
+	}
+	var names []string
+	for _, info := range infoList {
+		names = append(names, info.Name)
+	}
+	if len(names) != 2 || names[0] != "assignOp" || names[1] != "sloppyLen" {
+		t.Errorf("unexpected checkers: %v", names)
+	}
+
+	if _, err := SelectCheckers(Config{Enable: []string{"noSuchChecker"}}); err == nil {
+		t.Error("expected an error for empty checkers set")
+	}
+}
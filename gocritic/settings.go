@@ -0,0 +1,206 @@
+package gocritic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+// SettingsVersion is the latest Settings format version.
+const SettingsVersion = 1
+
+// Settings is a go-critic configuration in a form that is suitable
+// for the config files of the tools that embed go-critic, like golangci-lint.
+//
+// Wrappers should decode their config section into Settings and
+// use Translate to get the enabled checkers and their params instead
+// of mapping them on their own, so new checkers and params
+// become available without any changes on their side.
+type Settings struct {
+	// Version is a settings format version.
+	// Zero value means SettingsVersion.
+	Version int `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Enable is a list of enabled checkers names and #tags.
+	// If empty, the default checkers are enabled.
+	Enable []string `json:"enable,omitempty" yaml:"enable,omitempty"`
+
+	// Disable is a list of disabled checkers names and #tags.
+	// Disable has a priority over Enable.
+	Disable []string `json:"disable,omitempty" yaml:"disable,omitempty"`
+
+	// EnableAll enables every registered checker; Enable is ignored.
+	EnableAll bool `json:"enable-all,omitempty" yaml:"enable-all,omitempty"`
+
+	// Params maps checker names to a param name => value mapping.
+	//
+	// Values are converted to the param types, so the numbers
+	// decoded as float64 are accepted for the int params and
+	// lists of strings are accepted for the comma-separated list params.
+	Params map[string]map[string]interface{} `json:"params,omitempty" yaml:"params,omitempty"`
+
+	// GoVersion is a target Go version, like "1.20".
+	GoVersion string `json:"go,omitempty" yaml:"go,omitempty"`
+}
+
+// Translate validates s and converts it into a Config with
+// an explicit list of enabled checkers and typed params.
+//
+// Checker and param names are matched case-insensitively,
+// since some config loaders lowercase the map keys.
+// The returned Config has no Patterns set.
+func (s *Settings) Translate() (Config, error) {
+	if s.Version < 0 || s.Version > SettingsVersion {
+		return Config{}, fmt.Errorf("unsupported settings version %d (latest is %d)", s.Version, SettingsVersion)
+	}
+	if _, err := linter.ParseGoVersion(s.GoVersion); err != nil {
+		return Config{}, err
+	}
+
+	infoList := linter.GetCheckersInfo()
+	byName := make(map[string]*linter.CheckerInfo, len(infoList))
+	tags := make(map[string]string)
+	for _, info := range infoList {
+		byName[strings.ToLower(info.Name)] = info
+		for _, tag := range info.Tags {
+			tags[strings.ToLower(tag)] = tag
+		}
+	}
+
+	normalizeKeys := func(keys []string) ([]string, error) {
+		var normalized []string
+		for _, key := range keys {
+			key = strings.TrimSpace(key)
+			switch {
+			case key == "":
+				continue
+			case strings.HasPrefix(key, "#"):
+				tag, ok := tags[strings.ToLower(key[len("#"):])]
+				if !ok {
+					return nil, fmt.Errorf("unknown tag %q", key)
+				}
+				normalized = append(normalized, "#"+tag)
+			default:
+				info, ok := byName[strings.ToLower(key)]
+				if !ok {
+					return nil, fmt.Errorf("unknown checker %q", key)
+				}
+				normalized = append(normalized, info.Name)
+			}
+		}
+		return normalized, nil
+	}
+	enable, err := normalizeKeys(s.Enable)
+	if err != nil {
+		return Config{}, err
+	}
+	disable, err := normalizeKeys(s.Disable)
+	if err != nil {
+		return Config{}, err
+	}
+	if len(enable) == 0 {
+		enable = DefaultCheckers()
+	}
+	enabled := newMatcher(enable)
+	disabled := newMatcher(disable)
+
+	cfg := Config{GoVersion: s.GoVersion}
+	for _, info := range infoList {
+		if (s.EnableAll || enabled.match(info)) && !disabled.match(info) {
+			cfg.Enable = append(cfg.Enable, info.Name)
+		}
+	}
+	if len(cfg.Enable) == 0 {
+		return Config{}, errors.New("empty checkers set selected")
+	}
+
+	for name, values := range s.Params {
+		info, ok := byName[strings.ToLower(name)]
+		if !ok {
+			return Config{}, fmt.Errorf("unknown checker %q", name)
+		}
+		for pname, v := range values {
+			paramName, param := lookupParam(info, pname)
+			if param == nil {
+				return Config{}, fmt.Errorf("%s: unknown param %q", info.Name, pname)
+			}
+			value, err := convertParamValue(v, param.Value)
+			if err != nil {
+				return Config{}, fmt.Errorf("%s: param %q: %v", info.Name, paramName, err)
+			}
+			if cfg.Params == nil {
+				cfg.Params = make(map[string]map[string]interface{})
+			}
+			if cfg.Params[info.Name] == nil {
+				cfg.Params[info.Name] = make(map[string]interface{})
+			}
+			cfg.Params[info.Name][paramName] = value
+		}
+	}
+	return cfg, nil
+}
+
+func lookupParam(info *linter.CheckerInfo, name string) (string, *linter.CheckerParam) {
+	if p, ok := info.Params[name]; ok {
+		return name, p
+	}
+	// Sort the names, so the result doesn't depend on the map order.
+	names := make([]string, 0, len(info.Params))
+	for pname := range info.Params {
+		names = append(names, pname)
+	}
+	sort.Strings(names)
+	for _, pname := range names {
+		if strings.EqualFold(pname, name) {
+			return pname, info.Params[pname]
+		}
+	}
+	return "", nil
+}
+
+// convertParamValue converts a decoded config value v
+// to the type of the param default value def.
+func convertParamValue(v, def interface{}) (interface{}, error) {
+	switch def.(type) {
+	case int:
+		switch v := v.(type) {
+		case int:
+			return v, nil
+		case int64:
+			return int(v), nil
+		case uint64:
+			return int(v), nil
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("expects int value, got %v", v)
+			}
+			return int(v), nil
+		}
+	case bool:
+		if v, ok := v.(bool); ok {
+			return v, nil
+		}
+	case string:
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case []string:
+			return strings.Join(v, ","), nil
+		case []interface{}:
+			list := make([]string, len(v))
+			for i, x := range v {
+				s, ok := x.(string)
+				if !ok {
+					return nil, fmt.Errorf("expects a list of strings, got %T element", x)
+				}
+				list[i] = s
+			}
+			return strings.Join(list, ","), nil
+		}
+	}
+	return nil, fmt.Errorf("expects %T value, got %T", def, v)
+}
@@ -0,0 +1,119 @@
+package gocritic
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSettingsTranslate(t *testing.T) {
+	// Keys are lowercased like golangci-lint config loader does.
+	const data = `{
+		"version": 1,
+		"enable": ["sloppylen", "#Performance"],
+		"disable": ["hugeParam"],
+		"params": {
+			"rangevalcopy": {"sizethreshold": 256},
+			"captlocal": {"allownames": ["ID", "DB"]}
+		}
+	}`
+	var s Settings
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := s.Translate()
+	if err != nil {
+		t.Fatalf("translate: %v", err)
+	}
+
+	enabled := make(map[string]bool)
+	for _, name := range cfg.Enable {
+		enabled[name] = true
+	}
+	for _, name := range []string{"sloppyLen", "rangeValCopy", "hugeParam", "assignOp"} {
+		want := name != "hugeParam" && name != "assignOp"
+		if enabled[name] != want {
+			t.Errorf("%s: enabled=%v, want %v", name, enabled[name], want)
+		}
+	}
+
+	wantParams := map[string]map[string]interface{}{
+		"rangeValCopy": {"sizeThreshold": 256},
+		"captLocal":    {"allowNames": "ID,DB"},
+	}
+	if diff := cmp.Diff(wantParams, cfg.Params); diff != "" {
+		t.Errorf("params mismatch (-want +have):\n%s", diff)
+	}
+
+	// Translated params are accepted by Run.
+	restore, err := setParams(linter.GetCheckersInfo(), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restore()
+}
+
+func TestSettingsTranslateDefaults(t *testing.T) {
+	var s Settings
+	cfg, err := s.Translate()
+	if err != nil {
+		t.Fatalf("translate: %v", err)
+	}
+	if diff := cmp.Diff(DefaultCheckers(), cfg.Enable); diff != "" {
+		t.Errorf("enabled checkers mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestSettingsTranslateErrors(t *testing.T) {
+	tests := []struct {
+		settings Settings
+		err      string
+	}{
+		{
+			settings: Settings{Version: SettingsVersion + 1},
+			err:      "unsupported settings version",
+		},
+		{
+			settings: Settings{Enable: []string{"noSuchChecker"}},
+			err:      `unknown checker "noSuchChecker"`,
+		},
+		{
+			settings: Settings{Disable: []string{"#noSuchTag"}},
+			err:      `unknown tag "#noSuchTag"`,
+		},
+		{
+			settings: Settings{Enable: []string{"sloppyLen"}, Disable: []string{"#style"}},
+			err:      "empty checkers set selected",
+		},
+		{
+			settings: Settings{Params: map[string]map[string]interface{}{"hugeParam": {"noSuchParam": 1}}},
+			err:      `hugeParam: unknown param "noSuchParam"`,
+		},
+		{
+			settings: Settings{Params: map[string]map[string]interface{}{"hugeParam": {"sizeThreshold": 1.5}}},
+			err:      `hugeParam: param "sizeThreshold": expects int value, got 1.5`,
+		},
+		{
+			settings: Settings{Params: map[string]map[string]interface{}{"captLocal": {"paramsOnly": "yes"}}},
+			err:      `captLocal: param "paramsOnly": expects bool value, got string`,
+		},
+		{
+			settings: Settings{GoVersion: "go1"},
+			err:      "go1",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := test.settings.Translate()
+		if err == nil {
+			t.Errorf("%+v: expected an error", test.settings)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%+v: error %q doesn't contain %q", test.settings, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package gocritic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+)
+
+// readSource returns the contents of the f file, if it's
+// the same source that was parsed into f, nil otherwise.
+//
+// Analysis drivers don't pass the parsed sources to the analyzers
+// and the file on disk may differ from them, for example,
+// when gopls checks the unsaved editor buffers.
+// The file is parsed again and only accepted if every node and
+// comment has the same position and text as in f.
+func readSource(fset *token.FileSet, f *ast.File) []byte {
+	tf := fset.File(f.Pos())
+	if tf == nil {
+		return nil
+	}
+	src, err := ioutil.ReadFile(tf.Name())
+	if err != nil || len(src) != tf.Size() {
+		return nil
+	}
+	diskFset := token.NewFileSet()
+	diskFile, err := parser.ParseFile(diskFset, tf.Name(), src, parser.ParseComments)
+	if err != nil {
+		return nil
+	}
+	want := sourceTrace(fset, f)
+	have := sourceTrace(diskFset, diskFile)
+	if len(want) != len(have) {
+		return nil
+	}
+	for i := range want {
+		if want[i] != have[i] {
+			return nil
+		}
+	}
+	return src
+}
+
+// sourceTrace describes every f node and comment by its type,
+// source range and text of the identifiers, literals and operators.
+func sourceTrace(fset *token.FileSet, f *ast.File) []string {
+	offset := func(pos token.Pos) int {
+		if !pos.IsValid() {
+			return -1
+		}
+		return fset.Position(pos).Offset
+	}
+	var trace []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		text := ""
+		switch n := n.(type) {
+		case *ast.Ident:
+			text = n.Name
+		case *ast.BasicLit:
+			text = n.Value
+		case *ast.BinaryExpr:
+			text = n.Op.String()
+		case *ast.UnaryExpr:
+			text = n.Op.String()
+		case *ast.AssignStmt:
+			text = n.Tok.String()
+		case *ast.IncDecStmt:
+			text = n.Tok.String()
+		case *ast.BranchStmt:
+			text = n.Tok.String()
+		case *ast.GenDecl:
+			text = n.Tok.String()
+		case *ast.RangeStmt:
+			text = n.Tok.String()
+		case *ast.ChanType:
+			text = fmt.Sprint(n.Dir)
+		}
+		trace = append(trace, fmt.Sprintf("%T %d %d %s", n, offset(n.Pos()), offset(n.End()), text))
+		return true
+	})
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			trace = append(trace, fmt.Sprintf("%d %s", offset(c.Pos()), c.Text))
+		}
+	}
+	return trace
+}
@@ -0,0 +1,52 @@
+package gocritic
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gocritic-source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	const src = "package p\n\n// x is 3.\nvar x = 1 + 2\n"
+	filename := filepath.Join(dir, "p.go")
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		onDisk string
+		ok     bool
+	}{
+		{src, true},
+		{"package p\n\n// x is 3.\nvar x = 1 + 22\n", false},
+		{"package p\n\n// x is 3.\nvar x = 1 - 2\n", false},
+		{"package p\n\n// x is 3.\nvar y = 1 + 2\n", false},
+		{"package p\n\n// x is 4.\nvar x = 1 + 2\n", false},
+		{"package p\n\n// x is 3.\nvar x =  1 +2\n", false},
+	}
+	for _, test := range tests {
+		if err := ioutil.WriteFile(filename, []byte(test.onDisk), 0600); err != nil {
+			t.Fatal(err)
+		}
+		have := readSource(fset, f)
+		if (have != nil) != test.ok {
+			t.Errorf("%q: have source=%v, want %v", test.onDisk, have != nil, test.ok)
+		}
+	}
+
+	os.Remove(filename)
+	if readSource(fset, f) != nil {
+		t.Errorf("source of a removed file")
+	}
+}
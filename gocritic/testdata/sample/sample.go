@@ -0,0 +1,10 @@
+package sample
+
+func assign(x int) int {
+	x = x + 1
+	return x
+}
+
+func lenCheck(xs []int) bool {
+	return len(xs) >= 0
+}
@@ -0,0 +1,5 @@
+package a
+
+func f(a int, b int, xs []int) { // want `paramTypeCombine: func\(a int, b int, xs \[\]int\) could be replaced with func\(a, b int, xs \[\]int\)`
+	_ = len(xs) >= 0 // want `sloppyLen: len\(xs\) >= 0 is always true`
+}